@@ -16,8 +16,8 @@ data "sonarr_system_status" "sonarr" {}
 
 resource "sonarr_series" "mr-robot" {
   tvdb_id = "289590"
-  path = "/media/series"
-  quality_profile = 1
+  root_folder_path = "/media/series"
+  quality_profile_id = 1
   title = "Mr. Robot"
   monitored = true
   add_options = {
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// instanceDescription is the description of the instance attribute of every resource and data source.
+const instanceDescription = "Name of the Sonarr instance from the provider's instances map. Defaults to the instance configured by url and api_key."
+
+// Clients is the registry of Sonarr clients built by the provider's Configure and
+// handed to every resource and data source.
+type Clients struct {
+	// Default is the client of the instance configured by url and api_key, nil if only named instances exist.
+	Default *sonarr.Client
+	// Instances holds the clients of the named instances.
+	Instances map[string]*sonarr.Client
+}
+
+// Get returns the client selected by the instance attribute. A null or empty instance selects the default client.
+func (c *Clients) Get(instance types.String) (*sonarr.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if c == nil {
+		diags.AddError("Provider not configured", "client is nil")
+		return nil, diags
+	}
+
+	name := instance.ValueString()
+	if name == "" {
+		if c.Default == nil {
+			diags.AddAttributeError(path.Root("instance"), "Sonarr instance missing",
+				fmt.Sprintf("The provider has no default instance, set instance to one of: %s", c.names()))
+			return nil, diags
+		}
+		return c.Default, diags
+	}
+
+	client, ok := c.Instances[name]
+	if !ok {
+		diags.AddAttributeError(path.Root("instance"), "Unknown Sonarr instance",
+			fmt.Sprintf("The provider has no instance named %q, known instances: %s", name, c.names()))
+		return nil, diags
+	}
+	return client, diags
+}
+
+// names lists the configured instance names for diagnostics.
+func (c *Clients) names() string {
+	names := make([]string, 0, len(c.Instances))
+	for name := range c.Instances {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if len(names) == 0 {
+		return "(none)"
+	}
+	return strings.Join(names, ", ")
+}
+
+// resourceInstanceAttribute returns the instance attribute of a resource.
+// Moving a resource to another instance replaces it.
+func resourceInstanceAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Description: instanceDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// dataSourceInstanceAttribute returns the instance attribute of a data source.
+func dataSourceInstanceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Description: instanceDescription,
+	}
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// AllSeriesDataSource implements the data source for listing the whole Sonarr library.
+type AllSeriesDataSource struct {
+	clients *Clients
+}
+
+// AllSeriesDataSourceModel describes the data source data model.
+type AllSeriesDataSourceModel struct {
+	Instance         types.String      `tfsdk:"instance"`
+	Monitored        types.Bool        `tfsdk:"monitored"`
+	Tag              types.Int32       `tfsdk:"tag"`
+	QualityProfileId types.Int32       `tfsdk:"quality_profile_id"`
+	RootFolderPath   types.String      `tfsdk:"root_folder_path"`
+	SeriesType       types.String      `tfsdk:"series_type"`
+	Status           types.String      `tfsdk:"status"`
+	TitleRegex       types.String      `tfsdk:"title_regex"`
+	Series           []SeriesItemModel `tfsdk:"series"`
+}
+
+// SeriesItemModel describes a single series of the library.
+type SeriesItemModel struct {
+	ID               types.Int32  `tfsdk:"id"`
+	Title            types.String `tfsdk:"title"`
+	Path             types.String `tfsdk:"path"`
+	QualityProfileId types.Int32  `tfsdk:"quality_profile_id"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+	SeasonFolder     types.Bool   `tfsdk:"season_folder"`
+	TvdbId           types.Int32  `tfsdk:"tvdb_id"`
+	ImdbId           types.String `tfsdk:"imdb_id"`
+	TmdbId           types.Int32  `tfsdk:"tmdb_id"`
+	Year             types.Int32  `tfsdk:"year"`
+}
+
+func (a *AllSeriesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_all_series"
+}
+
+func (a *AllSeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Data source for listing all series in Sonarr. All filters are optional and combined with AND.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
+			"monitored": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list series with this monitored state",
+			},
+			"tag": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Only list series with this tag ID",
+			},
+			"quality_profile_id": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Only list series with this quality profile",
+			},
+			"root_folder_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list series stored in this root folder",
+			},
+			"series_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list series of this type (standard, daily, anime)",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list series with this status (continuing, ended, upcoming, deleted)",
+			},
+			"title_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list series whose title matches this regular expression (RE2 syntax)",
+			},
+			"series": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Series matching the filters, ordered by title",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: seriesItemAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// seriesItemAttributes returns the computed attributes describing a single series,
+// mirroring the sonarr_series data source.
+func seriesItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int32Attribute{
+			Computed:    true,
+			Description: "ID of the series in Sonarr",
+		},
+		"title": schema.StringAttribute{
+			Computed:    true,
+			Description: "Title of the series",
+		},
+		"path": schema.StringAttribute{
+			Computed:    true,
+			Description: "Full path to the series folder",
+		},
+		"quality_profile_id": schema.Int32Attribute{
+			Computed:    true,
+			Description: "Quality profile ID for the series",
+		},
+		"monitored": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the series is monitored",
+		},
+		"season_folder": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether to use season folders",
+		},
+		"tvdb_id": schema.Int32Attribute{
+			Computed:    true,
+			Description: "TVDB ID of the series",
+		},
+		"imdb_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "IMDb ID of the series",
+		},
+		"tmdb_id": schema.Int32Attribute{
+			Computed:    true,
+			Description: "TMDB ID of the series",
+		},
+		"year": schema.Int32Attribute{
+			Computed:    true,
+			Description: "Year the series started",
+		},
+	}
+}
+
+// newSeriesItemModel converts a series into its list item representation.
+func newSeriesItemModel(series *sonarr.Series) SeriesItemModel {
+	return SeriesItemModel{
+		ID:               types.Int32Value(series.Id),
+		Title:            types.StringValue(series.Title),
+		Path:             types.StringValue(series.Path),
+		QualityProfileId: types.Int32Value(series.QualityProfileId),
+		Monitored:        types.BoolValue(series.Monitored),
+		SeasonFolder:     types.BoolValue(series.SeasonFolder),
+		TvdbId:           types.Int32Value(series.TvdbID),
+		ImdbId:           types.StringValue(series.ImdbId),
+		TmdbId:           types.Int32Value(series.TmdbId),
+		Year:             types.Int32Value(series.Year),
+	}
+}
+
+func (a *AllSeriesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configuration type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+		return
+	}
+	a.clients = clients
+}
+
+func (a *AllSeriesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data AllSeriesDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := a.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var titleRegex *regexp.Regexp
+	if !data.TitleRegex.IsNull() {
+		var err error
+		titleRegex, err = regexp.Compile(data.TitleRegex.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("title_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	allSeries, err := client.GetAllSeries(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get series from Sonarr: %s", err.Error()))
+		return
+	}
+
+	slices.SortFunc(allSeries, func(x, y sonarr.Series) int {
+		return strings.Compare(strings.ToLower(x.Title), strings.ToLower(y.Title))
+	})
+
+	data.Series = make([]SeriesItemModel, 0, len(allSeries))
+	for i := range allSeries {
+		series := &allSeries[i]
+
+		if !data.Monitored.IsNull() && series.Monitored != data.Monitored.ValueBool() {
+			continue
+		}
+		if !data.Tag.IsNull() && !slices.Contains(series.Tags, data.Tag.ValueInt32()) {
+			continue
+		}
+		if !data.QualityProfileId.IsNull() && series.QualityProfileId != data.QualityProfileId.ValueInt32() {
+			continue
+		}
+		if !data.RootFolderPath.IsNull() && !samePath(seriesRootFolder(series), data.RootFolderPath.ValueString()) {
+			continue
+		}
+		if !data.SeriesType.IsNull() && !strings.EqualFold(series.SeriesType, data.SeriesType.ValueString()) {
+			continue
+		}
+		if !data.Status.IsNull() && !strings.EqualFold(series.Status, data.Status.ValueString()) {
+			continue
+		}
+		if titleRegex != nil && !titleRegex.MatchString(series.Title) {
+			continue
+		}
+
+		data.Series = append(data.Series, newSeriesItemModel(series))
+	}
+
+	diags = response.State.Set(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// NewAllSeriesDataSource creates a new instance of the all series data source.
+func NewAllSeriesDataSource() datasource.DataSource {
+	return &AllSeriesDataSource{}
+}
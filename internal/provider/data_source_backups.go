@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// BackupsDataSource implements the data source for the backup archives kept by Sonarr.
+type BackupsDataSource struct {
+	clients *Clients
+}
+
+// BackupsDataSourceModel describes the data source data model.
+type BackupsDataSourceModel struct {
+	Instance types.String  `tfsdk:"instance"`
+	Type     types.String  `tfsdk:"type"`
+	Backups  []BackupModel `tfsdk:"backups"`
+}
+
+// BackupModel describes a single backup archive.
+type BackupModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	Size types.Int64  `tfsdk:"size"`
+	Time types.String `tfsdk:"time"`
+}
+
+func (d *BackupsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_backups"
+}
+
+func (d *BackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Data source for the backup archives kept by Sonarr",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list backups of this type: scheduled, manual or update",
+				Validators: []validator.String{
+					stringvalidator.OneOf(sonarr.BackupTypeScheduled, sonarr.BackupTypeManual, sonarr.BackupTypeUpdate),
+				},
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Backups reported by Sonarr",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the backup",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "File name of the archive",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the backup: scheduled, manual or update",
+						},
+						"size": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Size of the archive in bytes",
+						},
+						"time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the backup was taken",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configuration type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+		return
+	}
+	d.clients = clients
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data BackupsDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := d.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	backups, err := client.GetBackups(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get backups from Sonarr: %s", err.Error()))
+		return
+	}
+
+	data.Backups = make([]BackupModel, 0, len(backups))
+	for _, backup := range backups {
+		if !data.Type.IsNull() && backup.Type != data.Type.ValueString() {
+			continue
+		}
+		data.Backups = append(data.Backups, BackupModel{
+			ID:   types.Int64Value(int64(backup.Id)),
+			Name: types.StringValue(backup.Name),
+			Type: types.StringValue(backup.Type),
+			Size: types.Int64Value(backup.Size),
+			Time: types.StringValue(backup.Time),
+		})
+	}
+
+	diags = response.State.Set(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// NewBackupsDataSource creates a new instance of the backups data source.
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
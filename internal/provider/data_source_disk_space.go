@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DiskSpaceDataSource implements the data source for the disks used by Sonarr.
+type DiskSpaceDataSource struct {
+	clients *Clients
+}
+
+// DiskSpaceDataSourceModel describes the data source data model.
+type DiskSpaceDataSourceModel struct {
+	Instance types.String     `tfsdk:"instance"`
+	Disks    []DiskSpaceModel `tfsdk:"disks"`
+}
+
+// DiskSpaceModel describes a single disk.
+type DiskSpaceModel struct {
+	Path       types.String `tfsdk:"path"`
+	Label      types.String `tfsdk:"label"`
+	FreeSpace  types.Int64  `tfsdk:"free_space"`
+	TotalSpace types.Int64  `tfsdk:"total_space"`
+}
+
+func (d *DiskSpaceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_disk_space"
+}
+
+func (d *DiskSpaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Data source for the free and total space of the disks used by Sonarr",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
+			"disks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Disks reported by Sonarr",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Mount point of the disk",
+						},
+						"label": schema.StringAttribute{
+							Computed:    true,
+							Description: "Label of the disk",
+						},
+						"free_space": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Free space in bytes",
+						},
+						"total_space": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Total space in bytes",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DiskSpaceDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configuration type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+		return
+	}
+	d.clients = clients
+}
+
+func (d *DiskSpaceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data DiskSpaceDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := d.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	disks, err := client.GetDiskSpace(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get disk space from Sonarr: %s", err.Error()))
+		return
+	}
+
+	data.Disks = make([]DiskSpaceModel, 0, len(disks))
+	for _, disk := range disks {
+		data.Disks = append(data.Disks, DiskSpaceModel{
+			Path:       types.StringValue(disk.Path),
+			Label:      types.StringValue(disk.Label),
+			FreeSpace:  types.Int64Value(disk.FreeSpace),
+			TotalSpace: types.Int64Value(disk.TotalSpace),
+		})
+	}
+
+	diags = response.State.Set(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// NewDiskSpaceDataSource creates a new instance of the disk space data source.
+func NewDiskSpaceDataSource() datasource.DataSource {
+	return &DiskSpaceDataSource{}
+}
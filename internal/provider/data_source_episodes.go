@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EpisodesDataSource implements the data source for listing the episodes of a series.
+type EpisodesDataSource struct {
+	clients *Clients
+}
+
+// EpisodesDataSourceModel describes the data source data model.
+type EpisodesDataSourceModel struct {
+	Instance     types.String   `tfsdk:"instance"`
+	SeriesId     types.Int32    `tfsdk:"series_id"`
+	SeasonNumber types.Int32    `tfsdk:"season_number"`
+	Episodes     []EpisodeModel `tfsdk:"episodes"`
+}
+
+// EpisodeModel describes a single episode.
+type EpisodeModel struct {
+	ID                    types.Int32  `tfsdk:"id"`
+	SeasonNumber          types.Int32  `tfsdk:"season_number"`
+	EpisodeNumber         types.Int32  `tfsdk:"episode_number"`
+	AbsoluteEpisodeNumber types.Int32  `tfsdk:"absolute_episode_number"`
+	Title                 types.String `tfsdk:"title"`
+	AirDate               types.String `tfsdk:"air_date"`
+	AirDateUtc            types.String `tfsdk:"air_date_utc"`
+	HasFile               types.Bool   `tfsdk:"has_file"`
+	EpisodeFileId         types.Int32  `tfsdk:"episode_file_id"`
+	Monitored             types.Bool   `tfsdk:"monitored"`
+}
+
+func (e *EpisodesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_episodes"
+}
+
+func (e *EpisodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Data source for listing the episodes of a series in Sonarr",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
+			"series_id": schema.Int32Attribute{
+				Required:    true,
+				Description: "ID of the series in Sonarr",
+			},
+			"season_number": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Only list episodes of this season (0 for specials)",
+			},
+			"episodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Episodes of the series",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the episode in Sonarr",
+						},
+						"season_number": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Season number",
+						},
+						"episode_number": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Episode number within the season",
+						},
+						"absolute_episode_number": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Absolute episode number (anime)",
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Title of the episode",
+						},
+						"air_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Local air date (YYYY-MM-DD)",
+						},
+						"air_date_utc": schema.StringAttribute{
+							Computed:    true,
+							Description: "Air date and time in UTC (RFC 3339)",
+						},
+						"has_file": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the episode has a file on disk",
+						},
+						"episode_file_id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the episode file, 0 if there is none",
+						},
+						"monitored": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the episode is monitored",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *EpisodesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configuration type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+		return
+	}
+	e.clients = clients
+}
+
+func (e *EpisodesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data EpisodesDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := e.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var seasonNumber *int
+	if !data.SeasonNumber.IsNull() {
+		season := int(data.SeasonNumber.ValueInt32())
+		seasonNumber = &season
+	}
+
+	episodes, err := client.GetEpisodes(ctx, int(data.SeriesId.ValueInt32()), seasonNumber)
+	if err != nil {
+		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get episodes from Sonarr: %s", err.Error()))
+		return
+	}
+
+	data.Episodes = make([]EpisodeModel, 0, len(episodes))
+	for _, episode := range episodes {
+		data.Episodes = append(data.Episodes, EpisodeModel{
+			ID:                    types.Int32Value(episode.Id),
+			SeasonNumber:          types.Int32Value(episode.SeasonNumber),
+			EpisodeNumber:         types.Int32Value(episode.EpisodeNumber),
+			AbsoluteEpisodeNumber: types.Int32Value(episode.AbsoluteEpisodeNumber),
+			Title:                 types.StringValue(episode.Title),
+			AirDate:               types.StringValue(episode.AirDate),
+			AirDateUtc:            types.StringValue(episode.AirDateUtc),
+			HasFile:               types.BoolValue(episode.HasFile),
+			EpisodeFileId:         types.Int32Value(episode.EpisodeFileId),
+			Monitored:             types.BoolValue(episode.Monitored),
+		})
+	}
+
+	diags = response.State.Set(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// NewEpisodesDataSource creates a new instance of the episodes data source.
+func NewEpisodesDataSource() datasource.DataSource {
+	return &EpisodesDataSource{}
+}
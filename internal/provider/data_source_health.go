@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HealthDataSource implements the data source for Sonarr's health checks.
+type HealthDataSource struct {
+	clients *Clients
+}
+
+// HealthDataSourceModel describes the data source data model.
+type HealthDataSourceModel struct {
+	Instance     types.String       `tfsdk:"instance"`
+	Checks       []HealthCheckModel `tfsdk:"checks"`
+	ErrorCount   types.Int32        `tfsdk:"error_count"`
+	WarningCount types.Int32        `tfsdk:"warning_count"`
+}
+
+// HealthCheckModel describes a single health check issue.
+type HealthCheckModel struct {
+	Source  types.String `tfsdk:"source"`
+	Type    types.String `tfsdk:"type"`
+	Message types.String `tfsdk:"message"`
+	WikiUrl types.String `tfsdk:"wiki_url"`
+}
+
+func (h *HealthDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_health"
+}
+
+func (h *HealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Data source for the issues reported by Sonarr's health checks. " +
+			"Use a postcondition on error_count to fail a plan when Sonarr is unhealthy.",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
+			"checks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Issues currently reported by Sonarr",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Computed:    true,
+							Description: "Health check reporting the issue",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Severity of the issue (ok, notice, warning, error)",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the issue",
+						},
+						"wiki_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "Link to the Servarr wiki entry for the issue",
+						},
+					},
+				},
+			},
+			"error_count": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Number of issues with the error severity",
+			},
+			"warning_count": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Number of issues with the warning severity",
+			},
+		},
+	}
+}
+
+func (h *HealthDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configuration type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+		return
+	}
+	h.clients = clients
+}
+
+func (h *HealthDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data HealthDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := h.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	checks, err := client.GetHealth(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get health from Sonarr: %s", err.Error()))
+		return
+	}
+
+	var errorCount, warningCount int32
+	data.Checks = make([]HealthCheckModel, 0, len(checks))
+	for _, check := range checks {
+		switch check.Type {
+		case "error":
+			errorCount++
+		case "warning":
+			warningCount++
+		}
+		data.Checks = append(data.Checks, HealthCheckModel{
+			Source:  types.StringValue(check.Source),
+			Type:    types.StringValue(check.Type),
+			Message: types.StringValue(check.Message),
+			WikiUrl: types.StringValue(check.WikiUrl),
+		})
+	}
+	data.ErrorCount = types.Int32Value(errorCount)
+	data.WarningCount = types.Int32Value(warningCount)
+
+	diags = response.State.Set(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// NewHealthDataSource creates a new instance of the health data source.
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// defaultHistoryLimit is the number of events listed when limit is not set, so an unfiltered
+// data source doesn't store the whole history of the instance in the state.
+const defaultHistoryLimit = 100
+
+// HistoryDataSource implements the data source for the events in Sonarr's history.
+type HistoryDataSource struct {
+	clients *Clients
+}
+
+// HistoryDataSourceModel describes the data source data model.
+type HistoryDataSourceModel struct {
+	Instance   types.String         `tfsdk:"instance"`
+	SeriesIds  []types.Int32        `tfsdk:"series_ids"`
+	EventTypes []types.String       `tfsdk:"event_types"`
+	Since      types.String         `tfsdk:"since"`
+	Until      types.String         `tfsdk:"until"`
+	Limit      types.Int64          `tfsdk:"limit"`
+	Records    []HistoryRecordModel `tfsdk:"records"`
+}
+
+// HistoryRecordModel describes a single history event.
+type HistoryRecordModel struct {
+	ID                  types.Int32  `tfsdk:"id"`
+	SeriesId            types.Int32  `tfsdk:"series_id"`
+	EpisodeId           types.Int32  `tfsdk:"episode_id"`
+	EventType           types.String `tfsdk:"event_type"`
+	SourceTitle         types.String `tfsdk:"source_title"`
+	Date                types.String `tfsdk:"date"`
+	DownloadId          types.String `tfsdk:"download_id"`
+	QualityCutoffNotMet types.Bool   `tfsdk:"quality_cutoff_not_met"`
+	Data                types.Map    `tfsdk:"data"`
+}
+
+func (h *HistoryDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_history"
+}
+
+func (h *HistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	eventTypes := slices.Sorted(maps.Keys(sonarr.HistoryEventTypes))
+
+	response.Schema = schema.Schema{
+		Description: "Data source for the events in Sonarr's history, most recent first",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
+			"series_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Description: "Only list events of these series",
+			},
+			"event_types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Only list events of these types: %v", eventTypes),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(eventTypes...)),
+				},
+			},
+			"since": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events at or after this time (RFC 3339)",
+			},
+			"until": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events at or before this time (RFC 3339)",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum number of events to list, %d by default", defaultHistoryLimit),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "History events, most recent first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the event",
+						},
+						"series_id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the series",
+						},
+						"episode_id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the episode",
+						},
+						"event_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the event",
+						},
+						"source_title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Title of the release or file the event is about",
+						},
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time of the event (RFC 3339)",
+						},
+						"download_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the download in the download client",
+						},
+						"quality_cutoff_not_met": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the quality is below the cutoff of the quality profile",
+						},
+						"data": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Event specific details, e.g. indexer, releaseGroup or message",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (h *HistoryDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configuration type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+		return
+	}
+	h.clients = clients
+}
+
+func (h *HistoryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data HistoryDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := h.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Limit.IsNull() {
+		data.Limit = types.Int64Value(defaultHistoryLimit)
+	}
+
+	options := sonarr.HistoryOptions{
+		SeriesIds: int32Values(data.SeriesIds),
+		Limit:     int(data.Limit.ValueInt64()),
+	}
+	for _, eventType := range data.EventTypes {
+		options.EventTypes = append(options.EventTypes, eventType.ValueString())
+	}
+	options.Since = parseTimeAttribute(data.Since, path.Root("since"), &response.Diagnostics)
+	options.Until = parseTimeAttribute(data.Until, path.Root("until"), &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := client.GetHistory(ctx, options)
+	if err != nil {
+		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get the history from Sonarr: %s", err.Error()))
+		return
+	}
+
+	data.Records = make([]HistoryRecordModel, 0, len(records))
+	for _, record := range records {
+		details, diags := types.MapValueFrom(ctx, types.StringType, record.Data)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		data.Records = append(data.Records, HistoryRecordModel{
+			ID:                  types.Int32Value(record.Id),
+			SeriesId:            types.Int32Value(record.SeriesId),
+			EpisodeId:           types.Int32Value(record.EpisodeId),
+			EventType:           types.StringValue(record.EventType),
+			SourceTitle:         types.StringValue(record.SourceTitle),
+			Date:                types.StringValue(record.Date),
+			DownloadId:          types.StringValue(record.DownloadId),
+			QualityCutoffNotMet: types.BoolValue(record.QualityCutoffNotMet),
+			Data:                details,
+		})
+	}
+
+	diags = response.State.Set(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// parseTimeAttribute parses an optional RFC 3339 attribute, returning the zero time if it is null.
+func parseTimeAttribute(value types.String, attribute path.Path, diags *diag.Diagnostics) time.Time {
+	if value.IsNull() {
+		return time.Time{}
+	}
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attribute, "Invalid time", fmt.Sprintf("The time must be in RFC 3339 format: %s", err.Error()))
+	}
+	return parsed
+}
+
+// NewHistoryDataSource creates a new instance of the history data source.
+func NewHistoryDataSource() datasource.DataSource {
+	return &HistoryDataSource{}
+}
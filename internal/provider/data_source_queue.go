@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// QueueDataSource implements the data source for the downloads in Sonarr's queue.
+type QueueDataSource struct {
+	clients *Clients
+}
+
+// QueueDataSourceModel describes the data source data model.
+type QueueDataSourceModel struct {
+	Instance             types.String     `tfsdk:"instance"`
+	SeriesIds            []types.Int32    `tfsdk:"series_ids"`
+	Status               []types.String   `tfsdk:"status"`
+	IncludeUnknownSeries types.Bool       `tfsdk:"include_unknown_series"`
+	Items                []QueueItemModel `tfsdk:"items"`
+}
+
+// QueueItemModel describes a single download.
+type QueueItemModel struct {
+	ID                      types.Int32    `tfsdk:"id"`
+	SeriesId                types.Int32    `tfsdk:"series_id"`
+	EpisodeId               types.Int32    `tfsdk:"episode_id"`
+	SeasonNumber            types.Int32    `tfsdk:"season_number"`
+	Title                   types.String   `tfsdk:"title"`
+	Status                  types.String   `tfsdk:"status"`
+	TrackedDownloadStatus   types.String   `tfsdk:"tracked_download_status"`
+	TrackedDownloadState    types.String   `tfsdk:"tracked_download_state"`
+	StatusMessages          []types.String `tfsdk:"status_messages"`
+	ErrorMessage            types.String   `tfsdk:"error_message"`
+	DownloadClient          types.String   `tfsdk:"download_client"`
+	Indexer                 types.String   `tfsdk:"indexer"`
+	Protocol                types.String   `tfsdk:"protocol"`
+	Size                    types.Int64    `tfsdk:"size"`
+	SizeLeft                types.Int64    `tfsdk:"size_left"`
+	TimeLeft                types.String   `tfsdk:"time_left"`
+	EstimatedCompletionTime types.String   `tfsdk:"estimated_completion_time"`
+	Added                   types.String   `tfsdk:"added"`
+}
+
+func (q *QueueDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_queue"
+}
+
+func (q *QueueDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{Computed: true, Description: description}
+	}
+
+	response.Schema = schema.Schema{
+		Description: "Data source for the downloads in Sonarr's queue",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
+			"series_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Description: "Only list downloads of these series",
+			},
+			"status": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only list downloads with one of these statuses, e.g. downloading, paused, queued, completed, warning or failed",
+			},
+			"include_unknown_series": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Also list downloads Sonarr couldn't match to a series",
+			},
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Downloads in the queue, the ones finishing first first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the queue item",
+						},
+						"series_id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the series, 0 if unknown",
+						},
+						"episode_id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the episode, 0 if unknown",
+						},
+						"season_number": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Season number",
+						},
+						"title":                   computedString("Release title"),
+						"status":                  computedString("Status reported by the download client"),
+						"tracked_download_status": computedString("Health of the download: ok, warning or error"),
+						"tracked_download_state":  computedString("Stage of the download, e.g. downloading, importPending or failedPending"),
+						"status_messages": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Warnings and errors reported for the download",
+						},
+						"error_message":   computedString("Error reported by the download client"),
+						"download_client": computedString("Name of the download client"),
+						"indexer":         computedString("Name of the indexer the release was grabbed from"),
+						"protocol":        computedString("Download protocol: usenet or torrent"),
+						"size": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Size of the release in bytes",
+						},
+						"size_left": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Bytes left to download",
+						},
+						"time_left":                 computedString("Estimated time left"),
+						"estimated_completion_time": computedString("Estimated time the download completes"),
+						"added":                     computedString("Time the download was added to the queue"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (q *QueueDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configuration type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+		return
+	}
+	q.clients = clients
+}
+
+func (q *QueueDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data QueueDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := q.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	options := sonarr.QueueOptions{
+		SeriesIds:                 int32Values(data.SeriesIds),
+		IncludeUnknownSeriesItems: data.IncludeUnknownSeries.ValueBool(),
+	}
+	for _, status := range data.Status {
+		options.Status = append(options.Status, status.ValueString())
+	}
+
+	items, err := client.GetQueue(ctx, options)
+	if err != nil {
+		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get the queue from Sonarr: %s", err.Error()))
+		return
+	}
+
+	data.Items = make([]QueueItemModel, 0, len(items))
+	for _, item := range items {
+		messages := []types.String{}
+		for _, status := range item.StatusMessages {
+			for _, message := range status.Messages {
+				messages = append(messages, types.StringValue(fmt.Sprintf("%s: %s", status.Title, message)))
+			}
+		}
+		data.Items = append(data.Items, QueueItemModel{
+			ID:                      types.Int32Value(item.Id),
+			SeriesId:                types.Int32Value(item.SeriesId),
+			EpisodeId:               types.Int32Value(item.EpisodeId),
+			SeasonNumber:            types.Int32Value(item.SeasonNumber),
+			Title:                   types.StringValue(item.Title),
+			Status:                  types.StringValue(item.Status),
+			TrackedDownloadStatus:   types.StringValue(item.TrackedDownloadStatus),
+			TrackedDownloadState:    types.StringValue(item.TrackedDownloadState),
+			StatusMessages:          messages,
+			ErrorMessage:            types.StringValue(item.ErrorMessage),
+			DownloadClient:          types.StringValue(item.DownloadClient),
+			Indexer:                 types.StringValue(item.Indexer),
+			Protocol:                types.StringValue(item.Protocol),
+			Size:                    types.Int64Value(int64(item.Size)),
+			SizeLeft:                types.Int64Value(int64(item.SizeLeft)),
+			TimeLeft:                types.StringValue(item.TimeLeft),
+			EstimatedCompletionTime: types.StringValue(item.EstimatedCompletionTime),
+			Added:                   types.StringValue(item.Added),
+		})
+	}
+
+	diags = response.State.Set(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// int32Values converts a list of Terraform numbers to plain numbers.
+func int32Values(values []types.Int32) []int32 {
+	result := make([]int32, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueInt32())
+	}
+	return result
+}
+
+// NewQueueDataSource creates a new instance of the queue data source.
+func NewQueueDataSource() datasource.DataSource {
+	return &QueueDataSource{}
+}
@@ -5,19 +5,22 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
 )
 
 // SeriesDataSource implements the data source for finding an existing series in Sonarr.
 type SeriesDataSource struct {
-	client *sonarr.Client
+	clients *Clients
 }
 
 // SeriesDataSourceModel describes the data source data model.
 type SeriesDataSourceModel struct {
+	Instance         types.String `tfsdk:"instance"`
 	ID               types.Int32  `tfsdk:"id"`
 	Title            types.String `tfsdk:"title"`
 	Path             types.String `tfsdk:"path"`
@@ -25,6 +28,9 @@ type SeriesDataSourceModel struct {
 	Monitored        types.Bool   `tfsdk:"monitored"`
 	SeasonFolder     types.Bool   `tfsdk:"season_folder"`
 	TvdbId           types.Int32  `tfsdk:"tvdb_id"`
+	ImdbId           types.String `tfsdk:"imdb_id"`
+	TmdbId           types.Int32  `tfsdk:"tmdb_id"`
+	Year             types.Int32  `tfsdk:"year"`
 }
 
 func (s *SeriesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -33,17 +39,21 @@ func (s *SeriesDataSource) Metadata(_ context.Context, request datasource.Metada
 
 func (s *SeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		Description: "Data source for finding a series in Sonarr by title",
+		Description: "Data source for finding a series in Sonarr by title, ID, TVDB ID, IMDb ID, TMDB ID or path. Exactly one selector must be set.",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
 			"title": schema.StringAttribute{
-				Required:    true,
-				Description: "Title of the series to find",
+				Optional:    true,
+				Computed:    true,
+				Description: "Title of the series to find (case-insensitive). Fails if more than one series has this title.",
 			},
 			"id": schema.Int32Attribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "ID of the series in Sonarr",
 			},
 			"path": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "Full path to the series folder",
 			},
@@ -60,26 +70,54 @@ func (s *SeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "Whether to use season folders",
 			},
 			"tvdb_id": schema.Int32Attribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "TVDB ID of the series",
 			},
+			"imdb_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "IMDb ID of the series (e.g. tt4158110)",
+			},
+			"tmdb_id": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "TMDB ID of the series",
+			},
+			"year": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Year the series started",
+			},
 		},
 	}
 }
 
+func (s *SeriesDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("title"),
+			path.MatchRoot("id"),
+			path.MatchRoot("tvdb_id"),
+			path.MatchRoot("imdb_id"),
+			path.MatchRoot("tmdb_id"),
+			path.MatchRoot("path"),
+		),
+	}
+}
+
 func (s *SeriesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
 	}
 
-	client, ok := request.ProviderData.(*sonarr.Client)
+	clients, ok := request.ProviderData.(*Clients)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unexpected Data Source Configuration type",
-			fmt.Sprintf("Expected *sonarr.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
 		return
 	}
-	s.client = client
+	s.clients = clients
 }
 
 func (s *SeriesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -91,28 +129,45 @@ func (s *SeriesDataSource) Read(ctx context.Context, request datasource.ReadRequ
 		return
 	}
 
-	if s.client == nil {
-		response.Diagnostics.AddError("Provider not configured", "client is nil")
+	client, diags := s.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
-	allSeries, err := s.client.GetAllSeries()
-	if err != nil {
-		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get series from Sonarr: %s", err.Error()))
-		return
-	}
-
-	searchTitle := strings.ToLower(data.Title.ValueString())
 	var found *sonarr.Series
-	for i := range allSeries {
-		if strings.ToLower(allSeries[i].Title) == searchTitle {
-			found = &allSeries[i]
-			break
+	if !data.ID.IsNull() {
+		series, err := client.GetSeries(ctx, int(data.ID.ValueInt32()))
+		if err != nil {
+			response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get series from Sonarr: %s", err.Error()))
+			return
+		}
+		found = series
+	} else {
+		allSeries, err := client.GetAllSeries(ctx)
+		if err != nil {
+			response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get series from Sonarr: %s", err.Error()))
+			return
+		}
+
+		matches := filterSeries(allSeries, &data)
+		if len(matches) > 1 {
+			candidates := make([]string, 0, len(matches))
+			for _, m := range matches {
+				candidates = append(candidates, fmt.Sprintf("%q (%d, TVDB ID %d, ID %d)", m.Title, m.Year, m.TvdbID, m.Id))
+			}
+			response.Diagnostics.AddError("Ambiguous series",
+				fmt.Sprintf("%d series match %s: %s. Use id, tvdb_id, imdb_id, tmdb_id or path to select one.",
+					len(matches), data.selector(), strings.Join(candidates, ", ")))
+			return
+		}
+		if len(matches) == 1 {
+			found = matches[0]
 		}
 	}
 
 	if found == nil {
-		response.Diagnostics.AddError("Series not found", fmt.Sprintf("No series found with title: %s", data.Title.ValueString()))
+		response.Diagnostics.AddError("Series not found", fmt.Sprintf("No series found with %s", data.selector()))
 		return
 	}
 
@@ -123,6 +178,9 @@ func (s *SeriesDataSource) Read(ctx context.Context, request datasource.ReadRequ
 	data.Monitored = types.BoolValue(found.Monitored)
 	data.SeasonFolder = types.BoolValue(found.SeasonFolder)
 	data.TvdbId = types.Int32Value(found.TvdbID)
+	data.ImdbId = types.StringValue(found.ImdbId)
+	data.TmdbId = types.Int32Value(found.TmdbId)
+	data.Year = types.Int32Value(found.Year)
 
 	diags = response.State.Set(ctx, &data)
 	if diags.HasError() {
@@ -131,6 +189,51 @@ func (s *SeriesDataSource) Read(ctx context.Context, request datasource.ReadRequ
 	}
 }
 
+// filterSeries returns the series matching the selector set in the model.
+func filterSeries(allSeries []sonarr.Series, data *SeriesDataSourceModel) []*sonarr.Series {
+	var matches []*sonarr.Series
+	for i := range allSeries {
+		series := &allSeries[i]
+
+		var match bool
+		switch {
+		case !data.Title.IsNull():
+			match = strings.EqualFold(series.Title, data.Title.ValueString())
+		case !data.TvdbId.IsNull():
+			match = series.TvdbID == data.TvdbId.ValueInt32()
+		case !data.ImdbId.IsNull():
+			match = strings.EqualFold(series.ImdbId, data.ImdbId.ValueString())
+		case !data.TmdbId.IsNull():
+			match = series.TmdbId == data.TmdbId.ValueInt32()
+		case !data.Path.IsNull():
+			match = samePath(series.Path, data.Path.ValueString())
+		}
+
+		if match {
+			matches = append(matches, series)
+		}
+	}
+	return matches
+}
+
+// selector describes the selector set in the model for diagnostics.
+func (data *SeriesDataSourceModel) selector() string {
+	switch {
+	case !data.ID.IsNull():
+		return fmt.Sprintf("ID %d", data.ID.ValueInt32())
+	case !data.Title.IsNull():
+		return fmt.Sprintf("title %q", data.Title.ValueString())
+	case !data.TvdbId.IsNull():
+		return fmt.Sprintf("TVDB ID %d", data.TvdbId.ValueInt32())
+	case !data.ImdbId.IsNull():
+		return fmt.Sprintf("IMDb ID %s", data.ImdbId.ValueString())
+	case !data.TmdbId.IsNull():
+		return fmt.Sprintf("TMDB ID %d", data.TmdbId.ValueInt32())
+	default:
+		return fmt.Sprintf("path %s", data.Path.ValueString())
+	}
+}
+
 // NewSeriesDataSource creates a new instance of the series data source.
 func NewSeriesDataSource() datasource.DataSource {
 	return &SeriesDataSource{}
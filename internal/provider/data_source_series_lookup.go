@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
 )
@@ -14,21 +16,67 @@ import (
 // SeriesLookupDataSource implements the data source for searching series on TVDB via Sonarr.
 // This is used to find series information before adding them to the Sonarr library.
 type SeriesLookupDataSource struct {
-	client *sonarr.Client
+	clients *Clients
 }
 
 // SeriesLookupDataSourceModel describes the data source data model.
 type SeriesLookupDataSourceModel struct {
-	Term        types.String `tfsdk:"term"`
+	Instance    types.String              `tfsdk:"instance"`
+	Term        types.String              `tfsdk:"term"`
+	Strict      types.Bool                `tfsdk:"strict"`
+	Title       types.String              `tfsdk:"title"`
+	SortTitle   types.String              `tfsdk:"sort_title"`
+	Status      types.String              `tfsdk:"status"`
+	Overview    types.String              `tfsdk:"overview"`
+	Network     types.String              `tfsdk:"network"`
+	Year        types.Int32               `tfsdk:"year"`
+	TvdbId      types.Int32               `tfsdk:"tvdb_id"`
+	ImdbId      types.String              `tfsdk:"imdb_id"`
+	TmdbId      types.Int32               `tfsdk:"tmdb_id"`
+	Runtime     types.Int32               `tfsdk:"runtime"`
+	SeasonCount types.Int32               `tfsdk:"season_count"`
+	Results     []SeriesLookupResultModel `tfsdk:"results"`
+
+	PosterUrl        types.String              `tfsdk:"poster_url"`
+	FanartUrl        types.String              `tfsdk:"fanart_url"`
+	Images           []SeriesImageModel        `tfsdk:"images"`
+	Genres           []types.String            `tfsdk:"genres"`
+	Certification    types.String              `tfsdk:"certification"`
+	FirstAired       types.String              `tfsdk:"first_aired"`
+	OriginalLanguage types.String              `tfsdk:"original_language"`
+	Ratings          *SeriesRatingsModel       `tfsdk:"ratings"`
+	Seasons          []SeriesLookupSeasonModel `tfsdk:"seasons"`
+}
+
+// SeriesImageModel describes an image of a series.
+type SeriesImageModel struct {
+	CoverType types.String `tfsdk:"cover_type"`
+	Url       types.String `tfsdk:"url"`
+}
+
+// SeriesRatingsModel describes the aggregated rating of a series.
+type SeriesRatingsModel struct {
+	Votes types.Int32   `tfsdk:"votes"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+// SeriesLookupSeasonModel describes a season of a looked up series.
+type SeriesLookupSeasonModel struct {
+	SeasonNumber      types.Int32 `tfsdk:"season_number"`
+	Monitored         types.Bool  `tfsdk:"monitored"`
+	EpisodeCount      types.Int32 `tfsdk:"episode_count"`
+	TotalEpisodeCount types.Int32 `tfsdk:"total_episode_count"`
+}
+
+// SeriesLookupResultModel describes a single candidate returned by the lookup.
+type SeriesLookupResultModel struct {
 	Title       types.String `tfsdk:"title"`
-	SortTitle   types.String `tfsdk:"sort_title"`
-	Status      types.String `tfsdk:"status"`
-	Overview    types.String `tfsdk:"overview"`
-	Network     types.String `tfsdk:"network"`
 	Year        types.Int32  `tfsdk:"year"`
+	Network     types.String `tfsdk:"network"`
+	Status      types.String `tfsdk:"status"`
 	TvdbId      types.Int32  `tfsdk:"tvdb_id"`
 	ImdbId      types.String `tfsdk:"imdb_id"`
-	Runtime     types.Int32  `tfsdk:"runtime"`
+	TmdbId      types.Int32  `tfsdk:"tmdb_id"`
 	SeasonCount types.Int32  `tfsdk:"season_count"`
 }
 
@@ -38,12 +86,20 @@ func (s *SeriesLookupDataSource) Metadata(_ context.Context, request datasource.
 
 func (s *SeriesLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		Description: "Data source for looking up series information from TVDB via Sonarr. Use this to find series details before adding them.",
+		Description: "Data source for looking up series information from TVDB via Sonarr. Use this to find series details before adding them. " +
+			"Exactly one of term, tvdb_id, imdb_id or tmdb_id must be set.",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
 			"term": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Description: "Search term to find the series (searches TVDB)",
 			},
+			"strict": schema.BoolAttribute{
+				Optional: true,
+				Description: "Fail unless a result has a title equal to term (case-insensitive). " +
+					"Without it the first result is used when there is no exact match. " +
+					"Several exact matches always fail, use year or network to select one.",
+			},
 			"title": schema.StringAttribute{
 				Computed:    true,
 				Description: "Title of the series",
@@ -61,20 +117,29 @@ func (s *SeriesLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: "Overview/description of the series",
 			},
 			"network": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Network the series airs on",
+				Description: "Network the series airs on. When set, only results from this network are considered.",
 			},
 			"year": schema.Int32Attribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Year the series started",
+				Description: "Year the series started. When set, only results from this year are considered.",
 			},
 			"tvdb_id": schema.Int32Attribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "TVDB ID of the series",
+				Description: "TVDB ID of the series. When set, the series is looked up by this ID.",
 			},
 			"imdb_id": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "IMDB ID of the series",
+				Description: "IMDB ID of the series. When set, the series is looked up by this ID.",
+			},
+			"tmdb_id": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "TMDB ID of the series. When set, the series is looked up by this ID.",
 			},
 			"runtime": schema.Int32Attribute{
 				Computed:    true,
@@ -84,23 +149,153 @@ func (s *SeriesLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:    true,
 				Description: "Number of seasons",
 			},
+			"poster_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the series poster",
+			},
+			"fanart_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the series fanart",
+			},
+			"images": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All images of the series",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cover_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the image (poster, fanart, banner, ...)",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "Remote URL of the image",
+						},
+					},
+				},
+			},
+			"genres": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Genres of the series",
+			},
+			"certification": schema.StringAttribute{
+				Computed:    true,
+				Description: "Content rating of the series (e.g. TV-MA)",
+			},
+			"first_aired": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date the first episode aired (RFC 3339)",
+			},
+			"original_language": schema.StringAttribute{
+				Computed:    true,
+				Description: "Original language of the series",
+			},
+			"ratings": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Aggregated rating of the series",
+				Attributes: map[string]schema.Attribute{
+					"votes": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of votes",
+					},
+					"value": schema.Float64Attribute{
+						Computed:    true,
+						Description: "Average rating",
+					},
+				},
+			},
+			"seasons": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Seasons of the series",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"season_number": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Season number (0 for specials)",
+						},
+						"monitored": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the season would be monitored",
+						},
+						"episode_count": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of aired episodes, 0 if Sonarr didn't report statistics",
+						},
+						"total_episode_count": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Total number of episodes, 0 if Sonarr didn't report statistics",
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Every candidate returned by the lookup, in the order Sonarr returned them",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Title of the series",
+						},
+						"year": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Year the series started",
+						},
+						"network": schema.StringAttribute{
+							Computed:    true,
+							Description: "Network the series airs on",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the series",
+						},
+						"tvdb_id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "TVDB ID of the series",
+						},
+						"imdb_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "IMDB ID of the series",
+						},
+						"tmdb_id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "TMDB ID of the series",
+						},
+						"season_count": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of seasons",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (s *SeriesLookupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("term"),
+			path.MatchRoot("tvdb_id"),
+			path.MatchRoot("imdb_id"),
+			path.MatchRoot("tmdb_id"),
+		),
+	}
+}
+
 func (s *SeriesLookupDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
 	}
 
-	client, ok := request.ProviderData.(*sonarr.Client)
+	clients, ok := request.ProviderData.(*Clients)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unexpected Data Source Configuration type",
-			fmt.Sprintf("Expected *sonarr.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
 		return
 	}
-	s.client = client
+	s.clients = clients
 }
 
 func (s *SeriesLookupDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -112,28 +307,90 @@ func (s *SeriesLookupDataSource) Read(ctx context.Context, request datasource.Re
 		return
 	}
 
-	results, err := s.client.LookupSeries(data.Term.ValueString())
+	client, diags := s.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Sonarr resolves prefixed terms against the matching metadata source.
+	term := data.Term.ValueString()
+	byID := true
+	switch {
+	case !data.TvdbId.IsNull():
+		term = fmt.Sprintf("tvdb:%d", data.TvdbId.ValueInt32())
+	case !data.ImdbId.IsNull():
+		term = "imdb:" + data.ImdbId.ValueString()
+	case !data.TmdbId.IsNull():
+		term = fmt.Sprintf("tmdb:%d", data.TmdbId.ValueInt32())
+	default:
+		byID = false
+	}
+
+	results, err := client.LookupSeries(ctx, term)
 	if err != nil {
 		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to lookup series: %s", err.Error()))
 		return
 	}
 
-	if len(results) == 0 {
-		response.Diagnostics.AddError("Series not found", fmt.Sprintf("No series found matching: %s", data.Term.ValueString()))
+	data.Results = make([]SeriesLookupResultModel, 0, len(results))
+	var candidates []*sonarr.SeriesLookup
+	for i := range results {
+		result := &results[i]
+		data.Results = append(data.Results, SeriesLookupResultModel{
+			Title:       types.StringValue(result.Title),
+			Year:        types.Int32Value(result.Year),
+			Network:     types.StringValue(result.Network),
+			Status:      types.StringValue(result.Status),
+			TvdbId:      types.Int32Value(result.TvdbId),
+			ImdbId:      types.StringValue(result.ImdbId),
+			TmdbId:      types.Int32Value(result.TmdbId),
+			SeasonCount: types.Int32Value(result.SeasonCount),
+		})
+
+		if !data.Year.IsNull() && result.Year != data.Year.ValueInt32() {
+			continue
+		}
+		if !data.Network.IsNull() && !strings.EqualFold(result.Network, data.Network.ValueString()) {
+			continue
+		}
+		candidates = append(candidates, result)
+	}
+
+	if len(candidates) == 0 {
+		response.Diagnostics.AddError("Series not found", fmt.Sprintf("No series found matching: %s", term))
 		return
 	}
 
-	// Find exact match first, otherwise use first result
-	searchTerm := strings.ToLower(data.Term.ValueString())
 	var found *sonarr.SeriesLookup
-	for i := range results {
-		if strings.ToLower(results[i].Title) == searchTerm {
-			found = &results[i]
-			break
+	if byID {
+		found = candidates[0]
+	} else {
+		var exact []*sonarr.SeriesLookup
+		for _, candidate := range candidates {
+			if strings.EqualFold(candidate.Title, term) {
+				exact = append(exact, candidate)
+			}
+		}
+
+		switch {
+		case len(exact) == 1:
+			found = exact[0]
+		case data.Strict.ValueBool() && len(exact) == 0:
+			response.Diagnostics.AddError("Series not found",
+				fmt.Sprintf("No result has the exact title %q; candidates: %s", term, describeLookupCandidates(candidates)))
+			return
+		case len(exact) > 1:
+			response.Diagnostics.AddError("Ambiguous series",
+				fmt.Sprintf("%d results have the title %q: %s. Use year or network to select one.",
+					len(exact), term, describeLookupCandidates(exact)))
+			return
+		default:
+			found = candidates[0]
+			response.Diagnostics.AddWarning("No exact match",
+				fmt.Sprintf("No result has the exact title %q, using %s. Set strict = true to fail instead.",
+					term, describeLookupCandidates(candidates[:1])))
 		}
-	}
-	if found == nil {
-		found = &results[0]
 	}
 
 	data.Title = types.StringValue(found.Title)
@@ -144,8 +401,64 @@ func (s *SeriesLookupDataSource) Read(ctx context.Context, request datasource.Re
 	data.Year = types.Int32Value(found.Year)
 	data.TvdbId = types.Int32Value(found.TvdbId)
 	data.ImdbId = types.StringValue(found.ImdbId)
+	data.TmdbId = types.Int32Value(found.TmdbId)
 	data.Runtime = types.Int32Value(found.Runtime)
 	data.SeasonCount = types.Int32Value(found.SeasonCount)
+	data.Certification = types.StringValue(found.Certification)
+	data.FirstAired = types.StringValue(found.FirstAired)
+
+	data.PosterUrl = types.StringValue("")
+	data.FanartUrl = types.StringValue("")
+	data.Images = make([]SeriesImageModel, 0, len(found.Images))
+	for _, image := range found.Images {
+		url := image.RemoteUrl
+		if url == "" {
+			url = image.Url
+		}
+		switch image.CoverType {
+		case "poster":
+			data.PosterUrl = types.StringValue(url)
+		case "fanart":
+			data.FanartUrl = types.StringValue(url)
+		}
+		data.Images = append(data.Images, SeriesImageModel{
+			CoverType: types.StringValue(image.CoverType),
+			Url:       types.StringValue(url),
+		})
+	}
+
+	data.Genres = make([]types.String, 0, len(found.Genres))
+	for _, genre := range found.Genres {
+		data.Genres = append(data.Genres, types.StringValue(genre))
+	}
+
+	data.OriginalLanguage = types.StringValue("")
+	if found.OriginalLanguage != nil {
+		data.OriginalLanguage = types.StringValue(found.OriginalLanguage.Name)
+	}
+
+	data.Ratings = nil
+	if found.Ratings != nil {
+		data.Ratings = &SeriesRatingsModel{
+			Votes: types.Int32Value(found.Ratings.Votes),
+			Value: types.Float64Value(found.Ratings.Value),
+		}
+	}
+
+	data.Seasons = make([]SeriesLookupSeasonModel, 0, len(found.Seasons))
+	for _, season := range found.Seasons {
+		model := SeriesLookupSeasonModel{
+			SeasonNumber:      types.Int32Value(season.SeasonNumber),
+			Monitored:         types.BoolValue(season.Monitored),
+			EpisodeCount:      types.Int32Value(0),
+			TotalEpisodeCount: types.Int32Value(0),
+		}
+		if season.Statistics != nil {
+			model.EpisodeCount = types.Int32Value(season.Statistics.EpisodeCount)
+			model.TotalEpisodeCount = types.Int32Value(season.Statistics.TotalEpisodeCount)
+		}
+		data.Seasons = append(data.Seasons, model)
+	}
 
 	diags = response.State.Set(ctx, &data)
 	if diags.HasError() {
@@ -154,6 +467,15 @@ func (s *SeriesLookupDataSource) Read(ctx context.Context, request datasource.Re
 	}
 }
 
+// describeLookupCandidates formats lookup results for diagnostics.
+func describeLookupCandidates(candidates []*sonarr.SeriesLookup) string {
+	parts := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		parts = append(parts, fmt.Sprintf("%q (%d, %s, TVDB ID %d)", c.Title, c.Year, c.Network, c.TvdbId))
+	}
+	return strings.Join(parts, ", ")
+}
+
 // NewSeriesLookupDataSource creates a new instance of the series lookup data source.
 func NewSeriesLookupDataSource() datasource.DataSource {
 	return &SeriesLookupDataSource{}
@@ -7,17 +7,43 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
 )
 
 type SystemStatusDataSource struct {
-	client *sonarr.Client
+	clients *Clients
 }
 
 type SystemStatusDataSourceModel struct {
-	AppName types.String `tfsdk:"app_name"`
-	Version types.String `tfsdk:"version"`
-	OsName  types.String `tfsdk:"os_name"`
+	Instance               types.String `tfsdk:"instance"`
+	AppName                types.String `tfsdk:"app_name"`
+	InstanceName           types.String `tfsdk:"instance_name"`
+	Version                types.String `tfsdk:"version"`
+	BuildTime              types.String `tfsdk:"build_time"`
+	IsDebug                types.Bool   `tfsdk:"is_debug"`
+	IsProduction           types.Bool   `tfsdk:"is_production"`
+	IsAdmin                types.Bool   `tfsdk:"is_admin"`
+	IsUserInteractive      types.Bool   `tfsdk:"is_user_interactive"`
+	StartupPath            types.String `tfsdk:"startup_path"`
+	AppData                types.String `tfsdk:"app_data"`
+	OsName                 types.String `tfsdk:"os_name"`
+	OsVersion              types.String `tfsdk:"os_version"`
+	IsLinux                types.Bool   `tfsdk:"is_linux"`
+	IsOsx                  types.Bool   `tfsdk:"is_osx"`
+	IsWindows              types.Bool   `tfsdk:"is_windows"`
+	IsDocker               types.Bool   `tfsdk:"is_docker"`
+	Mode                   types.String `tfsdk:"mode"`
+	Branch                 types.String `tfsdk:"branch"`
+	Authentication         types.String `tfsdk:"authentication"`
+	DatabaseType           types.String `tfsdk:"database_type"`
+	DatabaseVersion        types.String `tfsdk:"database_version"`
+	MigrationVersion       types.Int32  `tfsdk:"migration_version"`
+	UrlBase                types.String `tfsdk:"url_base"`
+	RuntimeName            types.String `tfsdk:"runtime_name"`
+	RuntimeVersion         types.String `tfsdk:"runtime_version"`
+	StartTime              types.String `tfsdk:"start_time"`
+	PackageVersion         types.String `tfsdk:"package_version"`
+	PackageAuthor          types.String `tfsdk:"package_author"`
+	PackageUpdateMechanism types.String `tfsdk:"package_update_mechanism"`
 }
 
 func (s *SystemStatusDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -28,18 +54,123 @@ func (s *SystemStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	response.Schema = schema.Schema{
 		Description: "System information data source",
 		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
 			"app_name": schema.StringAttribute{
 				Computed:    true,
 				Description: "App name of the instance (Sonarr)",
 			},
+			"instance_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Instance name configured in Sonarr",
+			},
 			"version": schema.StringAttribute{
 				Computed:    true,
 				Description: "Version of the Sonarr installation",
 			},
+			"build_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Build time of the Sonarr installation",
+			},
+			"is_debug": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Sonarr is a debug build",
+			},
+			"is_production": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Sonarr is a production build",
+			},
+			"is_admin": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Sonarr runs with administrative privileges",
+			},
+			"is_user_interactive": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Sonarr runs in an interactive session",
+			},
+			"startup_path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Directory Sonarr was started from",
+			},
+			"app_data": schema.StringAttribute{
+				Computed:    true,
+				Description: "Application data directory",
+			},
 			"os_name": schema.StringAttribute{
 				Computed:    true,
 				Description: "OS name of the sonarr installation",
 			},
+			"os_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "OS version of the sonarr installation",
+			},
+			"is_linux": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Sonarr runs on Linux",
+			},
+			"is_osx": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Sonarr runs on macOS",
+			},
+			"is_windows": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Sonarr runs on Windows",
+			},
+			"is_docker": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Sonarr runs in a Docker container",
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "Runtime mode (console, service, tray)",
+			},
+			"branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "Update branch (main, develop, ...)",
+			},
+			"authentication": schema.StringAttribute{
+				Computed:    true,
+				Description: "Authentication method (none, basic, forms, external)",
+			},
+			"database_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Database engine (sqLite, postgreSQL)",
+			},
+			"database_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Database engine version",
+			},
+			"migration_version": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Database migration version",
+			},
+			"url_base": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL base Sonarr is served under",
+			},
+			"runtime_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the .NET runtime",
+			},
+			"runtime_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the .NET runtime",
+			},
+			"start_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time Sonarr was started (RFC 3339)",
+			},
+			"package_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the installation package",
+			},
+			"package_author": schema.StringAttribute{
+				Computed:    true,
+				Description: "Author of the installation package",
+			},
+			"package_update_mechanism": schema.StringAttribute{
+				Computed:    true,
+				Description: "How Sonarr is updated (builtIn, docker, external, ...)",
+			},
 		},
 	}
 }
@@ -49,14 +180,14 @@ func (s *SystemStatusDataSource) Configure(_ context.Context, request datasource
 		return
 	}
 
-	client, ok := request.ProviderData.(*sonarr.Client)
+	clients, ok := request.ProviderData.(*Clients)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unexpected Data Source Configuration type",
-			fmt.Sprintf("Expected *sonarr.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
 		return
 	}
-	s.client = client
+	s.clients = clients
 }
 
 func (s *SystemStatusDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -68,14 +199,46 @@ func (s *SystemStatusDataSource) Read(ctx context.Context, request datasource.Re
 		return
 	}
 
-	status, err := s.client.GetSystemStatus()
+	client, diags := s.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := client.GetSystemStatus(ctx)
 	if err != nil {
 		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to communicate with Sonarr: %s", err.Error()))
 		return
 	}
-	data.OsName = types.StringValue(status.OsName)
-	data.Version = types.StringValue(status.Version)
 	data.AppName = types.StringValue(status.AppName)
+	data.InstanceName = types.StringValue(status.InstanceName)
+	data.Version = types.StringValue(status.Version)
+	data.BuildTime = types.StringValue(status.BuildTime)
+	data.IsDebug = types.BoolValue(status.IsDebug)
+	data.IsProduction = types.BoolValue(status.IsProduction)
+	data.IsAdmin = types.BoolValue(status.IsAdmin)
+	data.IsUserInteractive = types.BoolValue(status.IsUserInteractive)
+	data.StartupPath = types.StringValue(status.StartupPath)
+	data.AppData = types.StringValue(status.AppData)
+	data.OsName = types.StringValue(status.OsName)
+	data.OsVersion = types.StringValue(status.OsVersion)
+	data.IsLinux = types.BoolValue(status.IsLinux)
+	data.IsOsx = types.BoolValue(status.IsOsx)
+	data.IsWindows = types.BoolValue(status.IsWindows)
+	data.IsDocker = types.BoolValue(status.IsDocker)
+	data.Mode = types.StringValue(status.Mode)
+	data.Branch = types.StringValue(status.Branch)
+	data.Authentication = types.StringValue(status.Authentication)
+	data.DatabaseType = types.StringValue(status.DatabaseType)
+	data.DatabaseVersion = types.StringValue(status.DatabaseVersion)
+	data.MigrationVersion = types.Int32Value(status.MigrationVersion)
+	data.UrlBase = types.StringValue(status.UrlBase)
+	data.RuntimeName = types.StringValue(status.RuntimeName)
+	data.RuntimeVersion = types.StringValue(status.RuntimeVersion)
+	data.StartTime = types.StringValue(status.StartTime)
+	data.PackageVersion = types.StringValue(status.PackageVersion)
+	data.PackageAuthor = types.StringValue(status.PackageAuthor)
+	data.PackageUpdateMechanism = types.StringValue(status.PackageUpdateMechanism)
 
 	diags = response.State.Set(ctx, &data)
 	if diags.HasError() {
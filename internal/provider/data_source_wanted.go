@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// WantedDataSource implements the data source for the missing and cutoff unmet episodes.
+type WantedDataSource struct {
+	clients *Clients
+}
+
+// WantedDataSourceModel describes the data source data model.
+type WantedDataSourceModel struct {
+	Instance  types.String         `tfsdk:"instance"`
+	List      types.String         `tfsdk:"list"`
+	SeriesIds []types.Int32        `tfsdk:"series_ids"`
+	Monitored types.Bool           `tfsdk:"monitored"`
+	Episodes  []WantedEpisodeModel `tfsdk:"episodes"`
+}
+
+// WantedEpisodeModel describes a single wanted episode.
+type WantedEpisodeModel struct {
+	ID            types.Int32  `tfsdk:"id"`
+	SeriesId      types.Int32  `tfsdk:"series_id"`
+	SeasonNumber  types.Int32  `tfsdk:"season_number"`
+	EpisodeNumber types.Int32  `tfsdk:"episode_number"`
+	Title         types.String `tfsdk:"title"`
+	AirDateUtc    types.String `tfsdk:"air_date_utc"`
+	HasFile       types.Bool   `tfsdk:"has_file"`
+	Monitored     types.Bool   `tfsdk:"monitored"`
+}
+
+func (w *WantedDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_wanted"
+}
+
+func (w *WantedDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Data source for the episodes Sonarr still wants: aired episodes without a file, or files below the quality cutoff",
+		Attributes: map[string]schema.Attribute{
+			"instance": dataSourceInstanceAttribute(),
+			"list": schema.StringAttribute{
+				Required:    true,
+				Description: "Wanted list: missing for aired episodes without a file, cutoff for files below the quality cutoff",
+				Validators: []validator.String{
+					stringvalidator.OneOf(sonarr.WantedMissing, sonarr.WantedCutoff),
+				},
+			},
+			"series_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Description: "Only list episodes of these series",
+			},
+			"monitored": schema.BoolAttribute{
+				Optional:    true,
+				Description: "List monitored episodes when true (the default), unmonitored ones when false",
+			},
+			"episodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Wanted episodes, most recently aired first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the episode in Sonarr",
+						},
+						"series_id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the series",
+						},
+						"season_number": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Season number",
+						},
+						"episode_number": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Episode number within the season",
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Title of the episode",
+						},
+						"air_date_utc": schema.StringAttribute{
+							Computed:    true,
+							Description: "Air date and time in UTC (RFC 3339)",
+						},
+						"has_file": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the episode has a file on disk",
+						},
+						"monitored": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the episode is monitored",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (w *WantedDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configuration type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData))
+		return
+	}
+	w.clients = clients
+}
+
+func (w *WantedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data WantedDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := w.clients.Get(data.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	options := sonarr.WantedOptions{
+		SeriesIds: int32Values(data.SeriesIds),
+		Monitored: data.Monitored.IsNull() || data.Monitored.ValueBool(),
+	}
+
+	episodes, err := client.GetWanted(ctx, data.List.ValueString(), options)
+	if err != nil {
+		response.Diagnostics.AddError("Client error", fmt.Sprintf("Unable to get the wanted episodes from Sonarr: %s", err.Error()))
+		return
+	}
+
+	data.Episodes = make([]WantedEpisodeModel, 0, len(episodes))
+	for _, episode := range episodes {
+		data.Episodes = append(data.Episodes, WantedEpisodeModel{
+			ID:            types.Int32Value(episode.Id),
+			SeriesId:      types.Int32Value(episode.SeriesId),
+			SeasonNumber:  types.Int32Value(episode.SeasonNumber),
+			EpisodeNumber: types.Int32Value(episode.EpisodeNumber),
+			Title:         types.StringValue(episode.Title),
+			AirDateUtc:    types.StringValue(episode.AirDateUtc),
+			HasFile:       types.BoolValue(episode.HasFile),
+			Monitored:     types.BoolValue(episode.Monitored),
+		})
+	}
+
+	diags = response.State.Set(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// NewWantedDataSource creates a new instance of the wanted data source.
+func NewWantedDataSource() datasource.DataSource {
+	return &WantedDataSource{}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// defaultConfigXMLHost is the host used to build the URL of an instance read from config.xml.
+const defaultConfigXMLHost = "localhost"
+
+// ApiKeyEphemeralResource reads the API key and connection settings from a local Sonarr config.xml.
+// Nothing is written to the state.
+type ApiKeyEphemeralResource struct{}
+
+// ApiKeyEphemeralResourceModel describes the ephemeral resource data model.
+type ApiKeyEphemeralResourceModel struct {
+	ConfigXMLPath types.String `tfsdk:"config_xml_path"`
+	Host          types.String `tfsdk:"host"`
+	ApiKey        types.String `tfsdk:"api_key"`
+	Port          types.Int32  `tfsdk:"port"`
+	SslPort       types.Int32  `tfsdk:"ssl_port"`
+	EnableSsl     types.Bool   `tfsdk:"enable_ssl"`
+	UrlBase       types.String `tfsdk:"url_base"`
+	Url           types.String `tfsdk:"url"`
+}
+
+func (a *ApiKeyEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_api_key"
+}
+
+func (a *ApiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Ephemeral resource reading the API key and connection settings from a local Sonarr config.xml",
+		Attributes: map[string]schema.Attribute{
+			"config_xml_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path to Sonarr's config.xml",
+			},
+			"host": schema.StringAttribute{
+				Optional:    true,
+				Description: "Host used to build url, defaults to " + defaultConfigXMLHost,
+			},
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "API key of the instance",
+			},
+			"port": schema.Int32Attribute{
+				Computed:    true,
+				Description: "HTTP port of the instance, 8989 if config.xml doesn't set one",
+			},
+			"ssl_port": schema.Int32Attribute{
+				Computed:    true,
+				Description: "HTTPS port of the instance, 9898 if config.xml doesn't set one",
+			},
+			"enable_ssl": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether HTTPS is enabled",
+			},
+			"url_base": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL base the instance is served under",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base URL of the instance built from host, the port and the URL base",
+			},
+		},
+	}
+}
+
+func (a *ApiKeyEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ApiKeyEphemeralResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	config, err := sonarr.ReadConfigXML(data.ConfigXMLPath.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("config_xml_path"), "Unable to read config.xml", err.Error())
+		return
+	}
+
+	host := defaultConfigXMLHost
+	if !data.Host.IsNull() {
+		host = data.Host.ValueString()
+	}
+
+	data.ApiKey = types.StringValue(config.ApiKey)
+	data.Port = types.Int32Value(config.HTTPPort())
+	data.SslPort = types.Int32Value(config.HTTPSPort())
+	data.EnableSsl = types.BoolValue(config.EnableSsl)
+	data.UrlBase = types.StringValue(config.UrlBase)
+	data.Url = types.StringValue(config.URL(host))
+
+	diags = response.Result.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
+
+// NewApiKeyEphemeralResource creates a new instance of the API key ephemeral resource.
+func NewApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ApiKeyEphemeralResource{}
+}
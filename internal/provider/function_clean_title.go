@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// CleanTitleFunction implements provider::sonarr::clean_title.
+type CleanTitleFunction struct{}
+
+func (f *CleanTitleFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "clean_title"
+}
+
+func (f *CleanTitleFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:     "Clean a series title the way Sonarr does",
+		Description: "Applies the rules of Sonarr's {Series CleanTitle} naming token: \"&\" becomes \"and\", brackets and stray punctuation are removed and diacritics are stripped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "title",
+				Description: "Series title to clean",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CleanTitleFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var title string
+
+	response.Error = request.Arguments.Get(ctx, &title)
+	if response.Error != nil {
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, sonarr.CleanTitle(title))
+}
+
+// NewCleanTitleFunction creates a new instance of the clean_title function.
+func NewCleanTitleFunction() function.Function {
+	return &CleanTitleFunction{}
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// ParseReleaseFunction implements provider::sonarr::parse_release.
+type ParseReleaseFunction struct{}
+
+// ParsedReleaseModel describes the object returned by parse_release.
+type ParsedReleaseModel struct {
+	SeriesTitle    types.String  `tfsdk:"series_title"`
+	Year           types.Int64   `tfsdk:"year"`
+	SeasonNumber   types.Int64   `tfsdk:"season_number"`
+	EpisodeNumbers []types.Int64 `tfsdk:"episode_numbers"`
+	FullSeason     types.Bool    `tfsdk:"full_season"`
+	AirDate        types.String  `tfsdk:"air_date"`
+	Quality        types.String  `tfsdk:"quality"`
+	Source         types.String  `tfsdk:"source"`
+	Resolution     types.String  `tfsdk:"resolution"`
+	ReleaseGroup   types.String  `tfsdk:"release_group"`
+	Proper         types.Bool    `tfsdk:"proper"`
+	Repack         types.Bool    `tfsdk:"repack"`
+}
+
+func (f *ParseReleaseFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_release"
+}
+
+func (f *ParseReleaseFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary: "Parse a release name",
+		Description: "Extracts the series title and year (0 if absent), season and episode numbers (or air date), quality, source, resolution and " +
+			"release group from a release name using a local parser modeled on Sonarr's. Empty strings are returned for parts " +
+			"that can't be found; the function fails if neither a season, an episode nor an air date is present.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Release name, e.g. \"Mr.Robot.S01E01.1080p.WEB-DL.DD5.1.H264-GROUP\"",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"series_title":    types.StringType,
+				"year":            types.Int64Type,
+				"season_number":   types.Int64Type,
+				"episode_numbers": types.ListType{ElemType: types.Int64Type},
+				"full_season":     types.BoolType,
+				"air_date":        types.StringType,
+				"quality":         types.StringType,
+				"source":          types.StringType,
+				"resolution":      types.StringType,
+				"release_group":   types.StringType,
+				"proper":          types.BoolType,
+				"repack":          types.BoolType,
+			},
+		},
+	}
+}
+
+func (f *ParseReleaseFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var name string
+
+	response.Error = request.Arguments.Get(ctx, &name)
+	if response.Error != nil {
+		return
+	}
+
+	parsed, err := sonarr.ParseRelease(name)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	episodes := make([]types.Int64, 0, len(parsed.EpisodeNumbers))
+	for _, episode := range parsed.EpisodeNumbers {
+		episodes = append(episodes, types.Int64Value(int64(episode)))
+	}
+
+	response.Error = response.Result.Set(ctx, ParsedReleaseModel{
+		SeriesTitle:    types.StringValue(parsed.SeriesTitle),
+		Year:           types.Int64Value(int64(parsed.Year)),
+		SeasonNumber:   types.Int64Value(int64(parsed.SeasonNumber)),
+		EpisodeNumbers: episodes,
+		FullSeason:     types.BoolValue(parsed.FullSeason),
+		AirDate:        types.StringValue(parsed.AirDate),
+		Quality:        types.StringValue(parsed.Quality),
+		Source:         types.StringValue(parsed.Source),
+		Resolution:     types.StringValue(parsed.Resolution),
+		ReleaseGroup:   types.StringValue(parsed.ReleaseGroup),
+		Proper:         types.BoolValue(parsed.Proper),
+		Repack:         types.BoolValue(parsed.Repack),
+	})
+}
+
+// NewParseReleaseFunction creates a new instance of the parse_release function.
+func NewParseReleaseFunction() function.Function {
+	return &ParseReleaseFunction{}
+}
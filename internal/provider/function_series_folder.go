@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// SeriesFolderFunction implements provider::sonarr::series_folder.
+type SeriesFolderFunction struct{}
+
+func (f *SeriesFolderFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "series_folder"
+}
+
+func (f *SeriesFolderFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary: "Render a Sonarr series folder format offline",
+		Description: "Applies Sonarr's series folder naming tokens ({Series Title}, {Series TitleYear}, {Series CleanTitle}, " +
+			"{Series TitleThe}, {Series TitleFirstCharacter}, {Series Year}, {TvdbId} and their variants) without contacting Sonarr. " +
+			"An empty format uses Sonarr's default, " + sonarr.DefaultSeriesFolderFormat + ".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "format",
+				Description: "Series folder format, e.g. \"{Series TitleYear} [tvdbid-{TvdbId}]\"",
+			},
+			function.StringParameter{
+				Name:        "title",
+				Description: "Series title",
+			},
+			function.Int64Parameter{
+				Name:        "year",
+				Description: "Year the series started, 0 if unknown",
+			},
+			function.Int64Parameter{
+				Name:        "tvdb_id",
+				Description: "TVDB ID of the series, 0 if unknown",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SeriesFolderFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var format, title string
+	var year, tvdbId int64
+
+	response.Error = request.Arguments.Get(ctx, &format, &title, &year, &tvdbId)
+	if response.Error != nil {
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, sonarr.FormatSeriesFolder(format, title, int(year), int(tvdbId)))
+}
+
+// NewSeriesFolderFunction creates a new instance of the series_folder function.
+func NewSeriesFolderFunction() function.Function {
+	return &SeriesFolderFunction{}
+}
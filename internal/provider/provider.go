@@ -2,9 +2,19 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
 	"os"
+	"slices"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,13 +22,32 @@ import (
 	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
 )
 
+// defaultSeriesCacheTTL is the default lifetime of the series library cache, long enough
+// to share one download between the resources and data sources of a single plan or apply.
+const defaultSeriesCacheTTL = 30 * time.Second
+
 type SonarrProvider struct {
 	version string
 }
 
 type SonarrProviderModel struct {
-	Url    types.String `tfsdk:"url"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Url                types.String `tfsdk:"url"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	CACertificate      types.String `tfsdk:"ca_certificate"`
+	ConfigXMLPath      types.String `tfsdk:"config_xml_path"`
+	SkipVersionCheck   types.Bool   `tfsdk:"skip_version_check"`
+	SeriesCacheTTL     types.String `tfsdk:"series_cache_ttl"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	Instances          types.Map    `tfsdk:"instances"`
+}
+
+// SonarrInstanceModel describes a named Sonarr instance of the provider block.
+type SonarrInstanceModel struct {
+	Url                types.String `tfsdk:"url"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	CACertificate      types.String `tfsdk:"ca_certificate"`
 }
 
 func New(version string) func() provider.Provider {
@@ -46,6 +75,58 @@ func (sp *SonarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Description: "API key for the sonarr instance. Can also be set via SONARR_API_KEY environment variable.",
 				Optional:    true,
 			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the TLS certificate of the Sonarr server.",
+				Optional:    true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description: "PEM encoded CA certificate used to verify the TLS certificate of the Sonarr server.",
+				Optional:    true,
+			},
+			"config_xml_path": schema.StringAttribute{
+				Description: "Path to a local Sonarr config.xml. The API key and the URL (on " + defaultConfigXMLHost + ") are read from it when url or api_key are not set.",
+				Optional:    true,
+			},
+			"skip_version_check": schema.BoolAttribute{
+				Description: "Skip contacting Sonarr during provider configuration to check connectivity, the API key and the minimum supported version (" + sonarr.MinimumVersion + ").",
+				Optional:    true,
+			},
+			"series_cache_ttl": schema.StringAttribute{
+				Description: "How long the series library is cached and shared between resources and data sources, as a Go duration. " +
+					"Defaults to " + defaultSeriesCacheTTL.String() + ", \"0s\" disables the cache.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout of a single request made outside a resource operation, e.g. by data sources, as a Go duration. " +
+					"Resource operations are bounded by their timeouts block instead. Defaults to " + sonarr.DefaultRequestTimeout.String() + ", \"0s\" disables it.",
+				Optional: true,
+			},
+			"instances": schema.MapNestedAttribute{
+				Description: "Additional named Sonarr instances. Resources and data sources select one with their instance attribute; " +
+					"without it they use the instance configured by url and api_key.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "URL of the Sonarr server.",
+							Required:    true,
+						},
+						"api_key": schema.StringAttribute{
+							Description: "API key for the sonarr instance.",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"insecure_skip_verify": schema.BoolAttribute{
+							Description: "Skip verification of the TLS certificate of the Sonarr server.",
+							Optional:    true,
+						},
+						"ca_certificate": schema.StringAttribute{
+							Description: "PEM encoded CA certificate used to verify the TLS certificate of the Sonarr server.",
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -59,44 +140,212 @@ func (sp *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	if config.Url.IsUnknown() || config.ApiKey.IsUnknown() {
+	if config.Url.IsUnknown() || config.ApiKey.IsUnknown() || config.ConfigXMLPath.IsUnknown() || config.Instances.IsUnknown() {
 		return
 	}
 
+	instances := map[string]SonarrInstanceModel{}
+	res.Diagnostics.Append(config.Instances.ElementsAs(ctx, &instances, false)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ConfigXMLPath.IsNull() && (config.Url.IsNull() || config.ApiKey.IsNull()) {
+		xmlConfig, err := sonarr.ReadConfigXML(config.ConfigXMLPath.ValueString())
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root("config_xml_path"), "Unable to read config.xml", err.Error())
+			return
+		}
+		if config.Url.IsNull() {
+			config.Url = types.StringValue(xmlConfig.URL(defaultConfigXMLHost))
+		}
+		if config.ApiKey.IsNull() {
+			config.ApiKey = types.StringValue(xmlConfig.ApiKey)
+		}
+	}
+
 	if config.Url.IsNull() {
 		if v := os.Getenv("SONARR_URL"); v != "" {
 			config.Url = types.StringValue(v)
-		} else {
-			res.Diagnostics.AddError("Sonarr URL missing", "Sonarr URL should be provided")
-			return
 		}
 	}
 
 	if config.ApiKey.IsNull() {
 		if v := os.Getenv("SONARR_API_KEY"); v != "" {
 			config.ApiKey = types.StringValue(v)
-		} else {
-			res.Diagnostics.AddError("Sonarr API key missing", "Sonarr API key should be provided")
+		}
+	}
+
+	seriesCacheTTL := defaultSeriesCacheTTL
+	if !config.SeriesCacheTTL.IsNull() && !config.SeriesCacheTTL.IsUnknown() {
+		ttl, err := time.ParseDuration(config.SeriesCacheTTL.ValueString())
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root("series_cache_ttl"), "Invalid series cache TTL", err.Error())
+			return
+		}
+		seriesCacheTTL = ttl
+	}
+	requestTimeout := sonarr.DefaultRequestTimeout
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		timeout, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid request timeout", err.Error())
+			return
+		}
+		requestTimeout = timeout
+	}
+	clientOptions := []sonarr.ClientOption{
+		sonarr.WithSeriesCache(seriesCacheTTL),
+		sonarr.WithRequestTimeout(requestTimeout),
+	}
+
+	clients := &Clients{Instances: map[string]*sonarr.Client{}}
+
+	// The default instance is optional when named instances are configured.
+	if len(instances) == 0 || !config.Url.IsNull() || !config.ApiKey.IsNull() {
+		if config.Url.IsNull() {
+			res.Diagnostics.AddError("Sonarr URL missing", "Sonarr URL should be provided via url, config_xml_path or SONARR_URL")
 			return
 		}
+		if config.ApiKey.IsNull() {
+			res.Diagnostics.AddError("Sonarr API key missing", "Sonarr API key should be provided via api_key, config_xml_path or SONARR_API_KEY")
+			return
+		}
+
+		client, diags := newInstanceClient(SonarrInstanceModel{
+			Url:                config.Url,
+			ApiKey:             config.ApiKey,
+			InsecureSkipVerify: config.InsecureSkipVerify,
+			CACertificate:      config.CACertificate,
+		}, clientOptions...)
+		res.Diagnostics.Append(diags...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+		clients.Default = client
 	}
 
-	client := sonarr.NewClient(config.Url.ValueString(), config.ApiKey.ValueString())
+	for name, instance := range instances {
+		if instance.Url.IsUnknown() || instance.ApiKey.IsUnknown() {
+			return
+		}
 
-	res.DataSourceData = client
-	res.ResourceData = client
+		client, diags := newInstanceClient(instance, clientOptions...)
+		for _, d := range diags {
+			res.Diagnostics.AddAttributeError(path.Root("instances").AtMapKey(name), d.Summary(), d.Detail())
+		}
+		if res.Diagnostics.HasError() {
+			return
+		}
+		clients.Instances[name] = client
+	}
+
+	if !config.SkipVersionCheck.ValueBool() {
+		if clients.Default != nil {
+			res.Diagnostics.Append(checkVersion(ctx, clients.Default)...)
+		}
+		for name, client := range clients.Instances {
+			for _, d := range checkVersion(ctx, client) {
+				res.Diagnostics.AddAttributeError(path.Root("instances").AtMapKey(name), d.Summary(), d.Detail())
+			}
+		}
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	res.DataSourceData = clients
+	res.ResourceData = clients
+}
+
+// newInstanceClient creates a client for a Sonarr instance including its TLS settings.
+func newInstanceClient(instance SonarrInstanceModel, opts ...sonarr.ClientOption) (*sonarr.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if instance.InsecureSkipVerify.ValueBool() || instance.CACertificate.ValueString() != "" {
+		tlsConfig := &tls.Config{
+			InsecureSkipVerify: instance.InsecureSkipVerify.ValueBool(),
+		}
+		if pem := instance.CACertificate.ValueString(); pem != "" {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM([]byte(pem)) {
+				diags.AddError("Invalid CA certificate", "ca_certificate doesn't contain a PEM encoded certificate")
+				return nil, diags
+			}
+			tlsConfig.RootCAs = pool
+		}
+		opts = append(slices.Clip(opts), sonarr.WithTLSConfig(tlsConfig))
+	}
+
+	return sonarr.NewClient(instance.Url.ValueString(), instance.ApiKey.ValueString(), opts...), diags
+}
+
+// checkVersion contacts Sonarr and verifies the API key and the minimum supported version.
+// The detected version is stored on the client.
+func checkVersion(ctx context.Context, client *sonarr.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status, err := client.DetectVersion(ctx)
+	switch {
+	case errors.Is(err, sonarr.ErrUnauthorized):
+		diags.AddError("Sonarr API key rejected",
+			fmt.Sprintf("Sonarr at %s rejected the API key. Check api_key or SONARR_API_KEY.", client.BaseURL))
+		return diags
+	case err != nil:
+		diags.AddError("Unable to connect to Sonarr",
+			fmt.Sprintf("Unable to get the system status from %s: %s. Check url or SONARR_URL, or set skip_version_check to configure the provider offline.",
+				client.BaseURL, err.Error()))
+		return diags
+	}
+
+	if sonarr.CompareVersions(status.Version, sonarr.MinimumVersion) < 0 {
+		diags.AddError("Unsupported Sonarr version",
+			fmt.Sprintf("%s at %s runs version %s, the provider requires at least %s.",
+				status.AppName, client.BaseURL, status.Version, sonarr.MinimumVersion))
+	}
+	return diags
 }
 
 func (sp *SonarrProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewSystemStatusDataSource,
+		NewHealthDataSource,
+		NewDiskSpaceDataSource,
 		NewSeriesDataSource,
+		NewAllSeriesDataSource,
 		NewSeriesLookupDataSource,
+		NewEpisodesDataSource,
+		NewBackupsDataSource,
+		NewQueueDataSource,
+		NewHistoryDataSource,
+		NewWantedDataSource,
 	}
 }
 
 func (sp *SonarrProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSeriesResource,
+		NewSeriesCollectionResource,
+		NewAutoTaggingResource,
+		NewMetadataResource,
+		NewMetadataKodiResource,
+		NewMetadataEmbyResource,
+		NewEpisodeMonitoringResource,
+		NewCommandResource,
+		NewBackupResource,
+	}
+}
+
+func (sp *SonarrProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApiKeyEphemeralResource,
+	}
+}
+
+func (sp *SonarrProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCleanTitleFunction,
+		NewSeriesFolderFunction,
+		NewParseReleaseFunction,
 	}
 }
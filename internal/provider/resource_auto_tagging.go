@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// autoTaggingValueField is the name of the field holding the value of an auto tagging specification.
+const autoTaggingValueField = "value"
+
+// AutoTaggingResource manages Sonarr auto tagging rules.
+type AutoTaggingResource struct {
+	clients *Clients
+}
+
+type AutoTaggingResourceModel struct {
+	Instance                types.String                    `tfsdk:"instance"`
+	ID                      types.String                    `tfsdk:"id"`
+	Name                    types.String                    `tfsdk:"name"`
+	RemoveTagsAutomatically types.Bool                      `tfsdk:"remove_tags_automatically"`
+	Tags                    types.Set                       `tfsdk:"tags"`
+	Specifications          []AutoTaggingSpecificationModel `tfsdk:"specifications"`
+	Timeouts                timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+type AutoTaggingSpecificationModel struct {
+	Name           types.String         `tfsdk:"name"`
+	Implementation types.String         `tfsdk:"implementation"`
+	Negate         types.Bool           `tfsdk:"negate"`
+	Required       types.Bool           `tfsdk:"required"`
+	Value          jsontypes.Normalized `tfsdk:"value"`
+}
+
+func (a *AutoTaggingResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_auto_tagging"
+}
+
+func (a *AutoTaggingResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Resource for a Sonarr auto tagging rule",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the auto tagging rule",
+			},
+			"remove_tags_automatically": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Remove the tags from series that no longer match the rule",
+			},
+			"tags": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int32Type,
+				Description: "IDs of the tags applied to matching series",
+			},
+			"specifications": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Conditions a series has to match for the tags to be applied",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the specification",
+						},
+						"implementation": schema.StringAttribute{
+							Required:    true,
+							Description: "Specification implementation, e.g. GenreSpecification, SeriesTypeSpecification, RootFolderSpecification",
+						},
+						"negate": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Invert the result of the specification",
+						},
+						"required": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether the specification must match for the rule to apply",
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "JSON encoded value of the specification, e.g. jsonencode([\"anime\"]) for a genre list",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (a *AutoTaggingResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan AutoTaggingResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := a.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tagging, diags := plan.toAutoTagging(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := client.CreateAutoTagging(ctx, tagging)
+	if err != nil {
+		response.Diagnostics.AddError("Error creating auto tagging", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(plan.fromAutoTagging(result)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (a *AutoTaggingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state AutoTaggingResourceModel
+	diags := request.State.Get(ctx, &state)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := a.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing auto tagging ID", err.Error())
+		return
+	}
+
+	tagging, err := client.GetAutoTagging(ctx, id)
+	if err != nil {
+		response.Diagnostics.AddError("Error getting auto tagging", err.Error())
+		return
+	}
+
+	if tagging == nil {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	response.Diagnostics.Append(state.fromAutoTagging(tagging)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (a *AutoTaggingResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state AutoTaggingResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := a.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing auto tagging ID from the state", err.Error())
+		return
+	}
+
+	tagging, diags := plan.toAutoTagging(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	tagging.Id = int32(id)
+
+	result, err := client.UpdateAutoTagging(ctx, tagging)
+	if err != nil {
+		response.Diagnostics.AddError("Error updating auto tagging", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(plan.fromAutoTagging(result)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (a *AutoTaggingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state AutoTaggingResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := a.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Invalid ID format", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleting auto tagging", map[string]any{"id": id, "name": state.Name.ValueString()})
+
+	err = client.DeleteAutoTagging(ctx, id)
+	if err != nil {
+		response.Diagnostics.AddError("Error deleting auto tagging", err.Error())
+		return
+	}
+}
+
+func (a *AutoTaggingResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Clients, got: %T", request.ProviderData),
+		)
+		return
+	}
+
+	a.clients = clients
+}
+
+// toAutoTagging converts the Terraform model into the API representation.
+func (m *AutoTaggingResourceModel) toAutoTagging(ctx context.Context) (*sonarr.AutoTagging, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tagging := &sonarr.AutoTagging{
+		Name:                    m.Name.ValueString(),
+		RemoveTagsAutomatically: m.RemoveTagsAutomatically.ValueBool(),
+		Tags:                    []int32{},
+		Specifications:          make([]sonarr.AutoTaggingSpecification, 0, len(m.Specifications)),
+	}
+
+	diags.Append(m.Tags.ElementsAs(ctx, &tagging.Tags, false)...)
+
+	for _, spec := range m.Specifications {
+		var value any
+		if err := json.Unmarshal([]byte(spec.Value.ValueString()), &value); err != nil {
+			diags.AddError("Invalid specification value",
+				fmt.Sprintf("Value of specification %q must be valid JSON: %s", spec.Name.ValueString(), err.Error()))
+			continue
+		}
+
+		tagging.Specifications = append(tagging.Specifications, sonarr.AutoTaggingSpecification{
+			Name:           spec.Name.ValueString(),
+			Implementation: spec.Implementation.ValueString(),
+			Negate:         spec.Negate.ValueBool(),
+			Required:       spec.Required.ValueBool(),
+			Fields:         []sonarr.Field{{Name: autoTaggingValueField, Value: value}},
+		})
+	}
+
+	return tagging, diags
+}
+
+// fromAutoTagging copies the API representation into the Terraform model.
+func (m *AutoTaggingResourceModel) fromAutoTagging(tagging *sonarr.AutoTagging) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.Itoa(int(tagging.Id)))
+	m.Name = types.StringValue(tagging.Name)
+	m.RemoveTagsAutomatically = types.BoolValue(tagging.RemoveTagsAutomatically)
+
+	tags := make([]attr.Value, 0, len(tagging.Tags))
+	for _, tag := range tagging.Tags {
+		tags = append(tags, types.Int32Value(tag))
+	}
+	m.Tags, diags = types.SetValue(types.Int32Type, tags)
+
+	specs := make([]AutoTaggingSpecificationModel, 0, len(tagging.Specifications))
+	for _, spec := range tagging.Specifications {
+		value := "null"
+		for _, field := range spec.Fields {
+			if field.Name != autoTaggingValueField {
+				continue
+			}
+			encoded, err := json.Marshal(field.Value)
+			if err != nil {
+				diags.AddError("Error encoding specification value", err.Error())
+				continue
+			}
+			value = string(encoded)
+		}
+
+		specs = append(specs, AutoTaggingSpecificationModel{
+			Name:           types.StringValue(spec.Name),
+			Implementation: types.StringValue(spec.Implementation),
+			Negate:         types.BoolValue(spec.Negate),
+			Required:       types.BoolValue(spec.Required),
+			Value:          jsontypes.NewNormalizedValue(value),
+		})
+	}
+	m.Specifications = specs
+
+	return diags
+}
+
+// NewAutoTaggingResource creates a new instance of the auto tagging resource.
+func NewAutoTaggingResource() resource.Resource {
+	return &AutoTaggingResource{}
+}
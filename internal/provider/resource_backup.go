@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// BackupResource takes a manual Sonarr backup when it is created.
+// Destroying the resource leaves the backup to Sonarr's backup retention.
+type BackupResource struct {
+	clients *Clients
+}
+
+type BackupResourceModel struct {
+	Instance   types.String   `tfsdk:"instance"`
+	ID         types.Int64    `tfsdk:"id"`
+	Name       types.String   `tfsdk:"name"`
+	Type       types.String   `tfsdk:"type"`
+	Size       types.Int64    `tfsdk:"size"`
+	Time       types.String   `tfsdk:"time"`
+	Triggers   types.Map      `tfsdk:"triggers"`
+	DownloadTo types.String   `tfsdk:"download_to"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (b *BackupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_backup"
+}
+
+func (b *BackupResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	computed := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed:    true,
+			Description: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Description: "Takes a manual Sonarr backup when created, and again when triggers change. " +
+			"Destroying the resource leaves the backup to Sonarr's backup retention.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceAttribute(),
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the backup",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": computed("File name of the archive"),
+			"type": computed("Type of the backup, always manual"),
+			"size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Size of the archive in bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"time": computed("Time the backup was taken"),
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that take a new backup when they change",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"download_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Local path the archive is saved to. The download is verified to be a valid zip archive.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (b *BackupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan BackupResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := b.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	existing, err := client.GetBackups(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Error listing backups", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Starting backup")
+	command, err := client.StartCommand(ctx, "Backup", nil)
+	if err != nil {
+		response.Diagnostics.AddError("Error starting backup", err.Error())
+		return
+	}
+	response.Diagnostics.Append(waitForCommand(ctx, client, command)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	backups, err := client.GetBackups(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Error listing backups", err.Error())
+		return
+	}
+	backup := newestBackup(backups, existing)
+	if backup == nil {
+		response.Diagnostics.AddError("Error finding backup",
+			"The backup command completed but Sonarr lists no new manual backup.")
+		return
+	}
+	plan.fromBackup(backup)
+
+	// The backup exists whatever happens to the download, so it is kept in the state.
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+	if response.Diagnostics.HasError() || plan.DownloadTo.IsNull() {
+		return
+	}
+
+	if err := downloadBackup(ctx, client, backup, plan.DownloadTo.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("download_to"), "Error downloading backup", err.Error())
+	}
+}
+
+func (b *BackupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state BackupResourceModel
+	diags := request.State.Get(ctx, &state)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := b.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	backups, err := client.GetBackups(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Error listing backups", err.Error())
+		return
+	}
+
+	index := slices.IndexFunc(backups, func(backup sonarr.Backup) bool {
+		return int64(backup.Id) == state.ID.ValueInt64()
+	})
+	if index < 0 {
+		tflog.Warn(ctx, "Backup no longer exists, it was probably removed by the backup retention", map[string]any{"name": state.Name.ValueString()})
+		response.State.RemoveResource(ctx)
+		return
+	}
+	state.fromBackup(&backups[index])
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (b *BackupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state BackupResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := b.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only download_to and timeouts can change in place. A new download location gets the existing backup.
+	if !plan.DownloadTo.IsNull() && !plan.DownloadTo.Equal(state.DownloadTo) {
+		backups, err := client.GetBackups(ctx)
+		if err != nil {
+			response.Diagnostics.AddError("Error listing backups", err.Error())
+			return
+		}
+		index := slices.IndexFunc(backups, func(backup sonarr.Backup) bool {
+			return int64(backup.Id) == state.ID.ValueInt64()
+		})
+		if index < 0 {
+			response.Diagnostics.AddAttributeError(path.Root("download_to"), "Error downloading backup",
+				fmt.Sprintf("The backup %s no longer exists in Sonarr.", state.Name.ValueString()))
+			return
+		}
+		if err := downloadBackup(ctx, client, &backups[index], plan.DownloadTo.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("download_to"), "Error downloading backup", err.Error())
+			return
+		}
+	}
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (b *BackupResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The backup is left for Sonarr to clean up with its backup retention.
+}
+
+func (b *BackupResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Clients, got: %T", request.ProviderData),
+		)
+		return
+	}
+
+	b.clients = clients
+}
+
+// fromBackup copies the backup into the model.
+func (m *BackupResourceModel) fromBackup(backup *sonarr.Backup) {
+	m.ID = types.Int64Value(int64(backup.Id))
+	m.Name = types.StringValue(backup.Name)
+	m.Type = types.StringValue(backup.Type)
+	m.Size = types.Int64Value(backup.Size)
+	m.Time = types.StringValue(backup.Time)
+}
+
+// newestBackup returns the most recent manual backup of backups that is not in existing.
+func newestBackup(backups, existing []sonarr.Backup) *sonarr.Backup {
+	var newest *sonarr.Backup
+	var newestTime time.Time
+	for i := range backups {
+		backup := &backups[i]
+		if backup.Type != sonarr.BackupTypeManual || slices.ContainsFunc(existing, func(e sonarr.Backup) bool { return e.Name == backup.Name }) {
+			continue
+		}
+		taken, _ := time.Parse(time.RFC3339, backup.Time)
+		if newest == nil || taken.After(newestTime) {
+			newest, newestTime = backup, taken
+		}
+	}
+	return newest
+}
+
+// downloadBackup saves the archive of the backup to target. It is downloaded next to target and
+// only moved in place once it is verified, so a failed download never replaces a previous one.
+func downloadBackup(ctx context.Context, client *sonarr.Client, backup *sonarr.Backup, target string) error {
+	file, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if file != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	tflog.Info(ctx, "Downloading backup", map[string]any{"name": backup.Name, "path": target})
+	size, err := client.DownloadBackup(ctx, backup, file)
+	if err != nil {
+		return err
+	}
+	if err := verifyZipArchive(file, size); err != nil {
+		return fmt.Errorf("the downloaded backup %s is not a valid archive: %w", backup.Name, err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(file.Name(), target); err != nil {
+		return err
+	}
+	file = nil
+	return nil
+}
+
+// verifyZipArchive reads every entry of the zip archive, which checks their checksums.
+func verifyZipArchive(file io.ReaderAt, size int64) error {
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		return err
+	}
+	if len(archive.File) == 0 {
+		return errors.New("the archive is empty")
+	}
+	for _, entry := range archive.File {
+		reader, err := entry.Open()
+		if err != nil {
+			return fmt.Errorf("%s: %w", entry.Name, err)
+		}
+		_, err = io.Copy(io.Discard, reader)
+		_ = reader.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", entry.Name, err)
+		}
+	}
+	return nil
+}
+
+// NewBackupResource creates a new instance of the backup resource.
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
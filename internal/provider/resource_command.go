@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// CommandResource runs a Sonarr command, e.g. RssSync or RefreshSeries, when it is created.
+// Changing name, body or triggers runs the command again; destroying it does nothing.
+type CommandResource struct {
+	clients *Clients
+}
+
+var _ resource.ResourceWithValidateConfig = &CommandResource{}
+
+type CommandResourceModel struct {
+	Instance types.String   `tfsdk:"instance"`
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Body     types.String   `tfsdk:"body"`
+	Triggers types.Map      `tfsdk:"triggers"`
+	Wait     types.Bool     `tfsdk:"wait"`
+	Status   types.String   `tfsdk:"status"`
+	Started  types.String   `tfsdk:"started"`
+	Ended    types.String   `tfsdk:"ended"`
+	Duration types.String   `tfsdk:"duration"`
+	Message  types.String   `tfsdk:"message"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (c *CommandResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_command"
+}
+
+func (c *CommandResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	computed := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed:    true,
+			Description: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Description: "Runs a Sonarr command such as RssSync, RescanSeries, Backup or RefreshSeries. " +
+			"The command runs again when name, body or triggers change; destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceAttribute(),
+			"id":       computed("ID of the command in Sonarr"),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the command, e.g. RssSync, RescanSeries, RefreshSeries or Backup",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON object with the parameters of the command, e.g. jsonencode({ seriesId = 1 })",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that run the command again when they change",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Wait for the command to finish and fail unless it completed",
+			},
+			"status":   computed("Status of the command when it was last read: queued, started, completed, failed, aborted, cancelled or orphaned"),
+			"started":  computed("Time the command started"),
+			"ended":    computed("Time the command ended"),
+			"duration": computed("Time the command took to run"),
+			"message":  computed("Last message reported by the command"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (c *CommandResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config CommandResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Body.IsNull() || config.Body.IsUnknown() {
+		return
+	}
+	if _, err := commandBody(config.Body); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("body"), "Invalid command body",
+			fmt.Sprintf("The body must be a JSON object: %s", err.Error()))
+	}
+}
+
+func (c *CommandResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan CommandResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := c.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	body, err := commandBody(plan.Body)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("body"), "Invalid command body", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Starting command", map[string]any{"name": plan.Name.ValueString()})
+	command, err := client.StartCommand(ctx, plan.Name.ValueString(), body)
+	if err != nil {
+		response.Diagnostics.AddError("Error starting command", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(int(command.Id)))
+	plan.fromCommand(command)
+
+	// The command is running in Sonarr whatever happens next, so it is kept in the state.
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+	if response.Diagnostics.HasError() || !plan.Wait.ValueBool() {
+		return
+	}
+
+	finished, err := client.WaitForCommand(ctx, int(command.Id), commandPollInterval)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("Error waiting for %s", plan.Name.ValueString()), err.Error())
+		return
+	}
+	plan.fromCommand(finished)
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+
+	if finished.Status != sonarr.CommandStatusCompleted {
+		detail := finished.Exception
+		if detail == "" {
+			detail = finished.Message
+		}
+		response.Diagnostics.AddError(fmt.Sprintf("%s %s", finished.Name, finished.Status), detail)
+	}
+}
+
+func (c *CommandResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state CommandResourceModel
+	diags := request.State.Get(ctx, &state)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := c.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing command ID", err.Error())
+		return
+	}
+
+	command, err := client.GetCommand(ctx, id)
+	if err != nil {
+		response.Diagnostics.AddError("Error getting command", err.Error())
+		return
+	}
+	// Sonarr forgets finished commands after a while. The state keeps the last known
+	// status instead of removing the resource, which would run the command again.
+	if command == nil {
+		tflog.Debug(ctx, "Command no longer known to Sonarr", map[string]any{"id": id})
+		return
+	}
+
+	state.fromCommand(command)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (c *CommandResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan CommandResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Everything but wait and timeouts replaces the resource, and neither needs a call to Sonarr.
+	diags := response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (c *CommandResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// A command that ran can't be undone, there is nothing to remove in Sonarr.
+}
+
+func (c *CommandResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Clients, got: %T", request.ProviderData),
+		)
+		return
+	}
+
+	c.clients = clients
+}
+
+// fromCommand copies the progress of the command into the model.
+func (m *CommandResourceModel) fromCommand(command *sonarr.Command) {
+	m.Status = types.StringValue(command.Status)
+	m.Started = types.StringValue(command.Started)
+	m.Ended = types.StringValue(command.Ended)
+	m.Duration = types.StringValue(command.Duration)
+	m.Message = types.StringValue(command.Message)
+}
+
+// commandBody decodes the JSON body of the command. A null body is an empty one.
+func commandBody(body types.String) (map[string]any, error) {
+	parameters := map[string]any{}
+	if body.IsNull() || body.ValueString() == "" {
+		return parameters, nil
+	}
+	if err := json.Unmarshal([]byte(body.ValueString()), &parameters); err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
+// NewCommandResource creates a new instance of the command resource.
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EpisodeMonitoringResource owns the monitored flag of a set of episodes.
+// Destroying the resource leaves the flag of the episodes untouched.
+type EpisodeMonitoringResource struct {
+	clients *Clients
+}
+
+type EpisodeMonitoringResourceModel struct {
+	Instance   types.String   `tfsdk:"instance"`
+	ID         types.String   `tfsdk:"id"`
+	EpisodeIds []types.Int32  `tfsdk:"episode_ids"`
+	Monitored  types.Bool     `tfsdk:"monitored"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (e *EpisodeMonitoringResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_episode_monitoring"
+}
+
+func (e *EpisodeMonitoringResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Resource owning the monitored flag of a set of episodes. Destroying it leaves the episodes as they are.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"episode_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int32Type,
+				Description: "IDs of the episodes, e.g. from the sonarr_episodes data source",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"monitored": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the episodes are monitored",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (e *EpisodeMonitoringResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan EpisodeMonitoringResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := e.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ids := plan.episodeIds()
+	err := client.MonitorEpisodes(ctx, ids, plan.Monitored.ValueBool())
+	if err != nil {
+		response.Diagnostics.AddError("Error monitoring episodes", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(episodeMonitoringID(ids))
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (e *EpisodeMonitoringResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state EpisodeMonitoringResourceModel
+	diags := request.State.Get(ctx, &state)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := e.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	episodes, err := client.GetEpisodesByIds(ctx, state.episodeIds())
+	if err != nil {
+		response.Diagnostics.AddError("Error getting episodes", err.Error())
+		return
+	}
+
+	found := make(map[int32]bool, len(episodes))
+	monitored := state.Monitored.ValueBool()
+	for _, episode := range episodes {
+		found[episode.Id] = true
+		// Any drifted episode flips the flag in the state so the next plan restores it.
+		if episode.Monitored != state.Monitored.ValueBool() {
+			monitored = episode.Monitored
+		}
+	}
+	state.Monitored = types.BoolValue(monitored)
+
+	// Missing episodes stay in the state so the configuration keeps matching it.
+	var missing []string
+	for _, id := range state.episodeIds() {
+		if !found[id] {
+			missing = append(missing, strconv.Itoa(int(id)))
+		}
+	}
+	if len(missing) > 0 {
+		response.Diagnostics.AddAttributeWarning(path.Root("episode_ids"), "Episodes no longer exist",
+			fmt.Sprintf("The episodes %s no longer exist in Sonarr. Remove them from episode_ids.", strings.Join(missing, ", ")))
+	}
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (e *EpisodeMonitoringResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan EpisodeMonitoringResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := e.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	ids := plan.episodeIds()
+	err := client.MonitorEpisodes(ctx, ids, plan.Monitored.ValueBool())
+	if err != nil {
+		response.Diagnostics.AddError("Error monitoring episodes", err.Error())
+		return
+	}
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (e *EpisodeMonitoringResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The monitored flag belongs to the episodes, there is nothing to remove in Sonarr.
+}
+
+func (e *EpisodeMonitoringResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Clients, got: %T", request.ProviderData),
+		)
+		return
+	}
+
+	e.clients = clients
+}
+
+// episodeIds returns the sorted episode IDs of the model.
+func (m *EpisodeMonitoringResourceModel) episodeIds() []int32 {
+	ids := make([]int32, 0, len(m.EpisodeIds))
+	for _, id := range m.EpisodeIds {
+		ids = append(ids, id.ValueInt32())
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// episodeMonitoringID builds the resource ID from the sorted episode IDs at creation time.
+// The ID is kept stable when the set of episodes changes later on.
+func episodeMonitoringID(ids []int32) string {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.Itoa(int(id)))
+	}
+	return strings.Join(parts, ",")
+}
+
+// NewEpisodeMonitoringResource creates a new instance of the episode monitoring resource.
+func NewEpisodeMonitoringResource() resource.Resource {
+	return &EpisodeMonitoringResource{}
+}
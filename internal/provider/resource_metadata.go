@@ -0,0 +1,601 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// Metadata consumer implementations; Kodi and Emby have a typed convenience resource.
+const (
+	metadataImplementationKodi    = "XbmcMetadata"
+	metadataImplementationEmby    = "MediaBrowserMetadata"
+	metadataImplementationRoksbox = "RoksboxMetadata"
+	metadataImplementationWdtv    = "WdtvMetadata"
+	metadataImplementationPlex    = "PlexMetadata"
+)
+
+// MetadataResource manages a Sonarr metadata consumer.
+// Sonarr seeds one consumer per implementation, so Create adopts an existing consumer
+// of the same implementation and Delete only disables it.
+type MetadataResource struct {
+	clients *Clients
+
+	// implementation is fixed for the typed variants (sonarr_metadata_kodi, ...) and empty
+	// for the generic sonarr_metadata resource.
+	implementation string
+	typeSuffix     string
+}
+
+var _ resource.ResourceWithValidateConfig = &MetadataResource{}
+
+type MetadataResourceModel struct {
+	Instance          types.String   `tfsdk:"instance"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Implementation    types.String   `tfsdk:"implementation"`
+	Enable            types.Bool     `tfsdk:"enable"`
+	Tags              types.Set      `tfsdk:"tags"`
+	SeriesMetadata    types.Bool     `tfsdk:"series_metadata"`
+	SeriesMetadataUrl types.Bool     `tfsdk:"series_metadata_url"`
+	EpisodeMetadata   types.Bool     `tfsdk:"episode_metadata"`
+	SeriesImages      types.Bool     `tfsdk:"series_images"`
+	SeasonImages      types.Bool     `tfsdk:"season_images"`
+	EpisodeImages     types.Bool     `tfsdk:"episode_images"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// metadataBoolField maps a typed boolean attribute to the Sonarr field name
+// and lists the implementations exposing the setting.
+type metadataBoolField struct {
+	attribute       string
+	field           string
+	value           func(m *MetadataResourceModel) *types.Bool
+	implementations []string
+}
+
+// supports reports whether the implementation has the setting, assuming it does for
+// implementations whose settings are not known.
+func (f metadataBoolField) supports(implementation string) bool {
+	return !slices.Contains(metadataImplementations, implementation) || slices.Contains(f.implementations, implementation)
+}
+
+// metadataBoolFields lists the typed boolean settings of the metadata consumers.
+var metadataBoolFields = []metadataBoolField{
+	{"series_metadata", "seriesMetadata", func(m *MetadataResourceModel) *types.Bool { return &m.SeriesMetadata },
+		[]string{metadataImplementationKodi, metadataImplementationEmby}},
+	{"series_metadata_url", "seriesMetadataUrl", func(m *MetadataResourceModel) *types.Bool { return &m.SeriesMetadataUrl },
+		[]string{metadataImplementationKodi}},
+	{"episode_metadata", "episodeMetadata", func(m *MetadataResourceModel) *types.Bool { return &m.EpisodeMetadata },
+		[]string{metadataImplementationKodi, metadataImplementationRoksbox, metadataImplementationWdtv}},
+	{"series_images", "seriesImages", func(m *MetadataResourceModel) *types.Bool { return &m.SeriesImages },
+		[]string{metadataImplementationKodi, metadataImplementationRoksbox, metadataImplementationWdtv}},
+	{"season_images", "seasonImages", func(m *MetadataResourceModel) *types.Bool { return &m.SeasonImages },
+		[]string{metadataImplementationKodi, metadataImplementationRoksbox, metadataImplementationWdtv}},
+	{"episode_images", "episodeImages", func(m *MetadataResourceModel) *types.Bool { return &m.EpisodeImages },
+		[]string{metadataImplementationKodi, metadataImplementationRoksbox, metadataImplementationWdtv}},
+}
+
+// metadataImplementations lists the implementations whose settings are known.
+var metadataImplementations = []string{
+	metadataImplementationKodi,
+	metadataImplementationEmby,
+	metadataImplementationRoksbox,
+	metadataImplementationWdtv,
+	metadataImplementationPlex,
+}
+
+func (m *MetadataResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_metadata" + m.typeSuffix
+}
+
+func (m *MetadataResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	implementation := schema.StringAttribute{
+		Required:    true,
+		Description: "Metadata consumer implementation: XbmcMetadata (Kodi), MediaBrowserMetadata (Emby), RoksboxMetadata, WdtvMetadata or PlexMetadata",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	description := "Resource for a Sonarr metadata consumer. Destroying it disables the consumer."
+	if m.implementation != "" {
+		implementation = schema.StringAttribute{
+			Computed:    true,
+			Description: "Metadata consumer implementation",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+		description = fmt.Sprintf("Resource for the Sonarr %s metadata consumer. Destroying it disables the consumer.", m.implementation)
+	}
+
+	attributes := map[string]schema.Attribute{
+		"instance": resourceInstanceAttribute(),
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the metadata consumer. Defaults to the name Sonarr gave the consumer.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"implementation": implementation,
+		"enable": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Whether the metadata consumer is enabled",
+		},
+		"tags": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.Int32Type,
+			Default:     setdefault.StaticValue(types.SetValueMust(types.Int32Type, []attr.Value{})),
+			Description: "IDs of the tags restricting the consumer to matching series",
+		},
+	}
+	for _, f := range m.boolFields() {
+		attributes[f.attribute] = schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: fmt.Sprintf("Value of the %s setting, only available on %s", f.field, strings.Join(f.implementations, ", ")),
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Description: description,
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (m *MetadataResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config MetadataResourceModel
+	response.Diagnostics.Append(m.getModel(ctx, request.Config, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	implementation := m.implementation
+	if implementation == "" {
+		if config.Implementation.IsNull() || config.Implementation.IsUnknown() {
+			return
+		}
+		implementation = config.Implementation.ValueString()
+	}
+	// Sonarr ignores settings the consumer doesn't have, so they would always read back as false.
+	for _, f := range metadataBoolFields {
+		if f.value(&config).IsNull() || f.supports(implementation) {
+			continue
+		}
+		response.Diagnostics.AddAttributeError(path.Root(f.attribute), "Unsupported metadata setting",
+			fmt.Sprintf("The %s metadata consumer doesn't have the %s setting.", implementation, f.field))
+	}
+}
+
+func (m *MetadataResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan MetadataResourceModel
+	diags := m.getModel(ctx, request.Plan, &plan)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := m.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if m.implementation != "" {
+		plan.Implementation = types.StringValue(m.implementation)
+	}
+
+	all, err := client.GetAllMetadata(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Error listing metadata consumers", err.Error())
+		return
+	}
+
+	var existing *sonarr.Metadata
+	for i := range all {
+		if all[i].Implementation == plan.Implementation.ValueString() {
+			existing = &all[i]
+			break
+		}
+	}
+
+	metadata, diags := plan.toMetadata(ctx, existing)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var result *sonarr.Metadata
+	if existing != nil {
+		tflog.Info(ctx, "Adopting existing metadata consumer", map[string]any{"id": existing.Id, "implementation": existing.Implementation})
+		result, err = client.UpdateMetadata(ctx, metadata)
+	} else {
+		result, err = client.CreateMetadata(ctx, metadata)
+	}
+	if err != nil {
+		response.Diagnostics.AddError("Error creating metadata consumer", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(plan.fromMetadata(result)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = m.setModel(ctx, &response.State, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (m *MetadataResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state MetadataResourceModel
+	diags := m.getModel(ctx, request.State, &state)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := m.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing metadata consumer ID", err.Error())
+		return
+	}
+
+	metadata, err := client.GetMetadata(ctx, id)
+	if err != nil {
+		response.Diagnostics.AddError("Error getting metadata consumer", err.Error())
+		return
+	}
+
+	if metadata == nil {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	response.Diagnostics.Append(state.fromMetadata(metadata)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = m.setModel(ctx, &response.State, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (m *MetadataResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state MetadataResourceModel
+
+	response.Diagnostics.Append(m.getModel(ctx, request.Plan, &plan)...)
+	response.Diagnostics.Append(m.getModel(ctx, request.State, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := m.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing metadata consumer ID from the state", err.Error())
+		return
+	}
+
+	current, err := client.GetMetadata(ctx, id)
+	if err != nil {
+		response.Diagnostics.AddError("Error getting metadata consumer", err.Error())
+		return
+	}
+	if current == nil {
+		response.Diagnostics.AddError("Error updating metadata consumer", fmt.Sprintf("Metadata consumer %d no longer exists", id))
+		return
+	}
+
+	metadata, diags := plan.toMetadata(ctx, current)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := client.UpdateMetadata(ctx, metadata)
+	if err != nil {
+		response.Diagnostics.AddError("Error updating metadata consumer", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(plan.fromMetadata(result)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = m.setModel(ctx, &response.State, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (m *MetadataResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state MetadataResourceModel
+	diags := m.getModel(ctx, request.State, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := m.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Invalid ID format", err.Error())
+		return
+	}
+
+	metadata, err := client.GetMetadata(ctx, id)
+	if err != nil {
+		response.Diagnostics.AddError("Error getting metadata consumer", err.Error())
+		return
+	}
+	if metadata == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Disabling metadata consumer", map[string]any{"id": id, "implementation": metadata.Implementation})
+
+	metadata.Enable = false
+	_, err = client.UpdateMetadata(ctx, metadata)
+	if err != nil {
+		response.Diagnostics.AddError("Error disabling metadata consumer", err.Error())
+		return
+	}
+}
+
+func (m *MetadataResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Clients, got: %T", request.ProviderData),
+		)
+		return
+	}
+
+	m.clients = clients
+}
+
+// boolFields returns the boolean settings in the schema: all of them for the generic resource
+// and the ones the implementation has for the typed variants.
+func (m *MetadataResource) boolFields() []metadataBoolField {
+	if m.implementation == "" {
+		return metadataBoolFields
+	}
+	var fields []metadataBoolField
+	for _, f := range metadataBoolFields {
+		if slices.Contains(f.implementations, m.implementation) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// metadataData is implemented by tfsdk.Config, tfsdk.Plan and tfsdk.State.
+type metadataData interface {
+	Get(ctx context.Context, target any) diag.Diagnostics
+}
+
+// getModel reads data into model. The settings a typed variant doesn't have in its schema read as null.
+func (m *MetadataResource) getModel(ctx context.Context, data metadataData, model *MetadataResourceModel) diag.Diagnostics {
+	var object types.Object
+	diags := data.Get(ctx, &object)
+	if diags.HasError() {
+		return diags
+	}
+
+	attributeTypes := object.AttributeTypes(ctx)
+	attributes := object.Attributes()
+	for _, f := range metadataBoolFields {
+		if _, ok := attributeTypes[f.attribute]; !ok {
+			attributeTypes[f.attribute] = types.BoolType
+			attributes[f.attribute] = types.BoolNull()
+		}
+	}
+
+	full, d := types.ObjectValue(attributeTypes, attributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(full.As(ctx, model, basetypes.ObjectAsOptions{})...)
+	return diags
+}
+
+// setModel writes model into state, leaving out the settings a typed variant doesn't have in its schema.
+func (m *MetadataResource) setModel(ctx context.Context, state *tfsdk.State, model *MetadataResourceModel) diag.Diagnostics {
+	attributeTypes := state.Schema.Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+	fullTypes := maps.Clone(attributeTypes)
+	for _, f := range metadataBoolFields {
+		if _, ok := fullTypes[f.attribute]; !ok {
+			fullTypes[f.attribute] = types.BoolType
+		}
+	}
+
+	full, diags := types.ObjectValueFrom(ctx, fullTypes, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	attributes := full.Attributes()
+	maps.DeleteFunc(attributes, func(name string, _ attr.Value) bool {
+		_, ok := attributeTypes[name]
+		return !ok
+	})
+	object, d := types.ObjectValue(attributeTypes, attributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(state.Set(ctx, object)...)
+	return diags
+}
+
+// toMetadata converts the Terraform model into the API representation on top of current, the
+// consumer as Sonarr has it, or nil for a new consumer. Only the settings current has are
+// overwritten so the ones the resource doesn't manage keep their value.
+func (model *MetadataResourceModel) toMetadata(ctx context.Context, current *sonarr.Metadata) (*sonarr.Metadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metadata := &sonarr.Metadata{
+		Name:           model.Name.ValueString(),
+		Implementation: model.Implementation.ValueString(),
+		ConfigContract: model.Implementation.ValueString() + "Settings",
+		Enable:         model.Enable.ValueBool(),
+		Tags:           []int32{},
+	}
+
+	diags.Append(model.Tags.ElementsAs(ctx, &metadata.Tags, false)...)
+
+	if current != nil {
+		metadata.Id = current.Id
+		metadata.Fields = slices.Clone(current.Fields)
+		if metadata.Name == "" {
+			metadata.Name = current.Name
+		}
+	}
+
+	for _, f := range metadataBoolFields {
+		value := f.value(model)
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		i := slices.IndexFunc(metadata.Fields, func(field sonarr.Field) bool { return field.Name == f.field })
+		switch {
+		case i >= 0:
+			metadata.Fields[i].Value = value.ValueBool()
+		case current == nil && f.supports(metadata.Implementation):
+			metadata.Fields = append(metadata.Fields, sonarr.Field{Name: f.field, Value: value.ValueBool()})
+		}
+	}
+
+	return metadata, diags
+}
+
+// fromMetadata copies the API representation into the Terraform model.
+// Boolean settings the consumer doesn't expose are reported as false.
+func (model *MetadataResourceModel) fromMetadata(metadata *sonarr.Metadata) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(strconv.Itoa(int(metadata.Id)))
+	model.Name = types.StringValue(metadata.Name)
+	model.Implementation = types.StringValue(metadata.Implementation)
+	model.Enable = types.BoolValue(metadata.Enable)
+
+	tags := make([]attr.Value, 0, len(metadata.Tags))
+	for _, tag := range metadata.Tags {
+		tags = append(tags, types.Int32Value(tag))
+	}
+	model.Tags, diags = types.SetValue(types.Int32Type, tags)
+
+	values := make(map[string]bool, len(metadata.Fields))
+	for _, field := range metadata.Fields {
+		if v, ok := field.Value.(bool); ok {
+			values[field.Name] = v
+		}
+	}
+	for _, f := range metadataBoolFields {
+		*f.value(model) = types.BoolValue(values[f.field])
+	}
+
+	return diags
+}
+
+// NewMetadataResource creates a new instance of the generic metadata consumer resource.
+func NewMetadataResource() resource.Resource {
+	return &MetadataResource{}
+}
+
+// NewMetadataKodiResource creates a new instance of the Kodi (XBMC) metadata consumer resource.
+func NewMetadataKodiResource() resource.Resource {
+	return &MetadataResource{implementation: metadataImplementationKodi, typeSuffix: "_kodi"}
+}
+
+// NewMetadataEmbyResource creates a new instance of the Emby metadata consumer resource.
+func NewMetadataEmbyResource() resource.Resource {
+	return &MetadataResource{implementation: metadataImplementationEmby, typeSuffix: "_emby"}
+}
@@ -3,11 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
-
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,18 +24,39 @@ import (
 	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
 )
 
+const (
+	// defaultSeriesCreateTimeout leaves room for waiting for the refresh and search of a new series.
+	defaultSeriesCreateTimeout = 20 * time.Minute
+	// commandPollInterval is how often the status of a Sonarr command is checked.
+	commandPollInterval = 2 * time.Second
+)
+
 type SeriesResource struct {
-	client *sonarr.Client
+	clients *Clients
 }
 
+var (
+	_ resource.ResourceWithConfigValidators = &SeriesResource{}
+	_ resource.ResourceWithValidateConfig   = &SeriesResource{}
+	_ resource.ResourceWithModifyPlan       = &SeriesResource{}
+	_ resource.ResourceWithUpgradeState     = &SeriesResource{}
+)
+
 type SeriesResourceModel struct {
-	ID               types.String     `tfsdk:"id"`
+	Instance         types.String     `tfsdk:"instance"`
+	ID               types.Int64      `tfsdk:"id"`
 	TvdbId           types.Int32      `tfsdk:"tvdb_id"`
 	Title            types.String     `tfsdk:"title"`
+	RootFolderPath   types.String     `tfsdk:"root_folder_path"`
 	Path             types.String     `tfsdk:"path"`
 	Monitored        types.Bool       `tfsdk:"monitored"`
-	QualityProfileId types.Int32      `tfsdk:"quality_profile"`
+	QualityProfileId types.Int32      `tfsdk:"quality_profile_id"`
+	MoveFiles        types.Bool       `tfsdk:"move_files"`
+	AdoptExisting    types.Bool       `tfsdk:"adopt_existing"`
+	WaitForRefresh   types.Bool       `tfsdk:"wait_for_refresh"`
+	WaitForSearch    types.Bool       `tfsdk:"wait_for_search"`
 	AddOptions       *AddOptionsModel `tfsdk:"add_options"`
+	Timeouts         timeouts.Value   `tfsdk:"timeouts"`
 }
 
 type AddOptionsModel struct {
@@ -37,14 +67,16 @@ func (s *SeriesResource) Metadata(_ context.Context, request resource.MetadataRe
 	response.TypeName = request.ProviderTypeName + "_series"
 }
 
-func (s *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (s *SeriesResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Description: "Resource for the Sonarr Series",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
+			"instance": resourceInstanceAttribute(),
+			"id": schema.Int64Attribute{
 				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"tvdb_id": schema.Int32Attribute{
@@ -54,17 +86,60 @@ func (s *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"title": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"root_folder_path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Root folder of the series. Derived from path when only path is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"path": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Full path of the series folder. Sonarr names the folder inside root_folder_path when it is not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"monitored": schema.BoolAttribute{
 				Computed: true,
 				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"quality_profile": schema.Int32Attribute{
+			"quality_profile_id": schema.Int32Attribute{
 				Required: true,
 			},
+			"move_files": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Move the series folder on disk when path or root_folder_path changes, instead of only repointing the series",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Take ownership of the series when it is already in the library instead of failing, updating it to the configured settings",
+			},
+			"wait_for_refresh": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Wait for the metadata refresh Sonarr runs after adding the series before finishing the create",
+			},
+			"wait_for_search": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Search for the episodes of the new series once its metadata refresh finished, and wait for the search to finish",
+			},
 			"add_options": schema.SingleNestedAttribute{
 				Optional: true,
 				Attributes: map[string]schema.Attribute{
@@ -75,6 +150,9 @@ func (s *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -86,6 +164,47 @@ func (s *SeriesResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
+	client, diags := s.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultSeriesCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if plan.AdoptExisting.ValueBool() {
+		allSeries, err := client.GetAllSeries(ctx)
+		if err != nil {
+			response.Diagnostics.AddError("Error getting series", err.Error())
+			return
+		}
+		for i := range allSeries {
+			if allSeries[i].TvdbID != plan.TvdbId.ValueInt32() {
+				continue
+			}
+
+			tflog.Info(ctx, "Adopting existing series", map[string]any{"id": allSeries[i].Id, "title": allSeries[i].Title})
+			seriesRes, err := s.updateSeries(ctx, client, &allSeries[i], &plan)
+			if err != nil {
+				response.Diagnostics.AddError("Error adopting series", err.Error())
+				return
+			}
+
+			plan.ID = types.Int64Value(int64(seriesRes.Id))
+			plan.fromSeries(seriesRes)
+
+			diags = response.State.Set(ctx, &plan)
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	var addOpts *sonarr.AddOptions
 	if plan.AddOptions != nil {
 		addOpts = &sonarr.AddOptions{
@@ -97,24 +216,122 @@ func (s *SeriesResource) Create(ctx context.Context, request resource.CreateRequ
 		Title:            plan.Title.ValueString(),
 		TvdbID:           plan.TvdbId.ValueInt32(),
 		QualityProfileId: plan.QualityProfileId.ValueInt32(),
-		RootFolderPath:   plan.Path.ValueString(),
+		RootFolderPath:   plan.RootFolderPath.ValueString(),
+		Path:             plan.Path.ValueString(),
 		Monitored:        plan.Monitored.ValueBool(),
 		AddOptions:       addOpts,
 	}
 
-	seriesRes, err := s.client.CreateSeries(&seriesReq)
+	seriesRes, err := client.CreateSeries(ctx, &seriesReq)
 	if err != nil {
 		response.Diagnostics.AddError("Error creating series", err.Error())
 		return
 	}
 
-	plan.ID = types.StringValue(strconv.Itoa(int(seriesRes.Id)))
+	plan.ID = types.Int64Value(int64(seriesRes.Id))
+	plan.fromSeries(seriesRes)
 
 	diags = response.State.Set(ctx, &plan)
 	if diags.HasError() {
 		response.Diagnostics.Append(diags...)
 		return
 	}
+
+	if !plan.WaitForRefresh.ValueBool() && !plan.WaitForSearch.ValueBool() {
+		return
+	}
+
+	// The series exists at this point, a failed wait leaves it in the state as tainted
+	response.Diagnostics.Append(waitForSeriesCommands(ctx, client, seriesRes.Id, plan.WaitForSearch.ValueBool())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	refreshed, err := client.GetSeries(ctx, int(seriesRes.Id))
+	if err != nil {
+		response.Diagnostics.AddError("Error getting series", err.Error())
+		return
+	}
+	if refreshed != nil {
+		plan.fromSeries(refreshed)
+	}
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+// waitForSeriesCommands waits for the RefreshSeries command Sonarr queued for a new series and,
+// with search, runs a SeriesSearch for it afterwards and waits for that too.
+func waitForSeriesCommands(ctx context.Context, client *sonarr.Client, seriesId int32, search bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	refresh, err := findSeriesCommand(ctx, client, "RefreshSeries", seriesId)
+	if err != nil {
+		diags.AddError("Error waiting for series refresh", err.Error())
+		return diags
+	}
+	diags.Append(waitForCommand(ctx, client, refresh)...)
+	if diags.HasError() || !search {
+		return diags
+	}
+
+	tflog.Info(ctx, "Searching for episodes of the series", map[string]any{"id": seriesId})
+	command, err := client.StartCommand(ctx, "SeriesSearch", map[string]any{"seriesId": seriesId})
+	if err != nil {
+		diags.AddError("Error starting series search", err.Error())
+		return diags
+	}
+	diags.Append(waitForCommand(ctx, client, command)...)
+	return diags
+}
+
+// findSeriesCommand polls Sonarr's command list until the named command for the series shows up.
+func findSeriesCommand(ctx context.Context, client *sonarr.Client, name string, seriesId int32) (*sonarr.Command, error) {
+	ticker := time.NewTicker(commandPollInterval)
+	defer ticker.Stop()
+
+	for {
+		commands, err := client.GetCommands(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// The most recent command wins if the series was refreshed before
+		var found *sonarr.Command
+		for i := range commands {
+			if commands[i].Name == name && commands[i].ForSeries(seriesId) && (found == nil || commands[i].Id > found.Id) {
+				found = &commands[i]
+			}
+		}
+		if found != nil {
+			return found, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("no %s command for series %d showed up: %w", name, seriesId, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForCommand waits for a command to finish and reports it as an error unless it completed.
+func waitForCommand(ctx context.Context, client *sonarr.Client, command *sonarr.Command) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Waiting for command", map[string]any{"id": command.Id, "name": command.Name})
+	finished, err := client.WaitForCommand(ctx, int(command.Id), commandPollInterval)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error waiting for %s", command.Name), err.Error())
+		return diags
+	}
+	if finished.Status != sonarr.CommandStatusCompleted {
+		detail := finished.Exception
+		if detail == "" {
+			detail = finished.Message
+		}
+		diags.AddError(fmt.Sprintf("%s %s", finished.Name, finished.Status), detail)
+	}
+	return diags
 }
 
 func (s *SeriesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -125,18 +342,27 @@ func (s *SeriesResource) Read(ctx context.Context, request resource.ReadRequest,
 		return
 	}
 
-	if state.ID.IsNull() || state.ID.ValueString() == "" {
-		response.State.RemoveResource(ctx)
+	client, diags := s.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
-	id, err := strconv.Atoi(state.ID.ValueString())
-	if err != nil {
-		response.Diagnostics.AddError("Error parsing series ID", err.Error())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	if state.ID.IsNull() || state.ID.ValueInt64() == 0 {
+		response.State.RemoveResource(ctx)
 		return
 	}
 
-	seriesReq, err := s.client.GetSeries(id)
+	id := int(state.ID.ValueInt64())
+	seriesReq, err := client.GetSeries(ctx, id)
 	if err != nil {
 		response.Diagnostics.AddError("Error getting series", err.Error())
 		return
@@ -148,10 +374,8 @@ func (s *SeriesResource) Read(ctx context.Context, request resource.ReadRequest,
 	}
 
 	state.TvdbId = types.Int32Value(seriesReq.TvdbID)
-	state.Title = types.StringValue(seriesReq.Title)
-	state.Path = types.StringValue(seriesReq.RootFolderPath)
-	state.Monitored = types.BoolValue(seriesReq.Monitored)
 	state.QualityProfileId = types.Int32Value(seriesReq.QualityProfileId)
+	state.fromSeries(seriesReq)
 
 	diags = response.State.Set(ctx, &state)
 	response.Diagnostics.Append(diags...)
@@ -167,13 +391,22 @@ func (s *SeriesResource) Update(ctx context.Context, request resource.UpdateRequ
 		return
 	}
 
-	id, err := strconv.Atoi(state.ID.ValueString())
-	if err != nil {
-		response.Diagnostics.AddError("Error parsing series ID from the state", err.Error())
+	client, diags := s.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
-	currentSeries, err := s.client.GetSeries(id)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id := int(state.ID.ValueInt64())
+	currentSeries, err := client.GetSeries(ctx, id)
 	if err != nil {
 		response.Diagnostics.AddError("Error fetching series", err.Error())
 		return
@@ -183,20 +416,50 @@ func (s *SeriesResource) Update(ctx context.Context, request resource.UpdateRequ
 		return
 	}
 
-	currentSeries.Title = plan.Title.ValueString()
-	currentSeries.Monitored = plan.Monitored.ValueBool()
-	currentSeries.RootFolderPath = plan.Path.ValueString()
-	currentSeries.QualityProfileId = plan.QualityProfileId.ValueInt32()
-	currentSeries.TvdbID = plan.TvdbId.ValueInt32()
-
-	_, err = s.client.UpdateSeries(currentSeries)
+	_, err = s.updateSeries(ctx, client, currentSeries, &plan)
 	if err != nil {
 		response.Diagnostics.AddError("Error updating series", err.Error())
 		return
 	}
 
 	plan.ID = state.ID
-	response.State.Set(ctx, &plan)
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+// updateSeries applies the plan to a series in Sonarr, moving it when its path changes.
+// Unknown computed values in the plan are filled in from the series. The rest of current,
+// including the monitoring of its seasons, is sent back unchanged.
+func (s *SeriesResource) updateSeries(ctx context.Context, client *sonarr.Client, current *sonarr.Series, plan *SeriesResourceModel) (*sonarr.Series, error) {
+	// Sonarr relocates a series through its path, ModifyPlan keeps path in line with root_folder_path
+	switch {
+	case plan.Path.IsUnknown() && plan.RootFolderPath.IsUnknown():
+		plan.Path = types.StringValue(current.Path)
+	case plan.Path.IsUnknown():
+		plan.Path = types.StringValue(seriesPathInRootFolder(current.Path, plan.RootFolderPath.ValueString()))
+	}
+	if plan.RootFolderPath.IsUnknown() {
+		plan.RootFolderPath = types.StringValue(parentFolder(plan.Path.ValueString()))
+	}
+	moved := !samePath(plan.Path.ValueString(), current.Path)
+	if moved {
+		tflog.Info(ctx, "Moving series", map[string]any{
+			"id": current.Id, "from": current.Path, "to": plan.Path.ValueString(), "move_files": plan.MoveFiles.ValueBool(),
+		})
+	}
+
+	if !plan.Title.IsUnknown() {
+		current.Title = plan.Title.ValueString()
+	}
+	if !plan.Monitored.IsUnknown() {
+		current.Monitored = plan.Monitored.ValueBool()
+	}
+	current.RootFolderPath = plan.RootFolderPath.ValueString()
+	current.Path = plan.Path.ValueString()
+	current.QualityProfileId = plan.QualityProfileId.ValueInt32()
+	current.TvdbID = plan.TvdbId.ValueInt32()
+
+	return client.UpdateSeries(ctx, current, moved && plan.MoveFiles.ValueBool())
 }
 
 func (s *SeriesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -207,19 +470,29 @@ func (s *SeriesResource) Delete(ctx context.Context, request resource.DeleteRequ
 		return
 	}
 
-	if state.ID.IsNull() || state.ID.IsUnknown() || state.ID.ValueString() == "" {
+	client, diags := s.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
-	id, err := strconv.Atoi(state.ID.ValueString())
-	if err != nil {
-		response.Diagnostics.AddError("Invalid ID format", err.Error())
+	if state.ID.IsNull() || state.ID.IsUnknown() || state.ID.ValueInt64() == 0 {
 		return
 	}
 
+	id := int(state.ID.ValueInt64())
+
 	tflog.Info(ctx, "Deleting series", map[string]any{"id": id, "title": state.Title.ValueString()})
 
-	err = s.client.DeleteSeries(id, true)
+	err := client.DeleteSeries(ctx, id, true)
 	if err != nil {
 		response.Diagnostics.AddError("Error Deleting Series", err.Error())
 		return
@@ -231,16 +504,179 @@ func (s *SeriesResource) Configure(_ context.Context, request resource.Configure
 		return
 	}
 
-	client, ok := request.ProviderData.(*sonarr.Client)
+	clients, ok := request.ProviderData.(*Clients)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sonarr.Client, got: %T", request.ProviderData),
+			fmt.Sprintf("Expected *Clients, got: %T", request.ProviderData),
 		)
 		return
 	}
 
-	s.client = client
+	s.clients = clients
+}
+
+func (s *SeriesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("root_folder_path"),
+			path.MatchRoot("path"),
+		),
+	}
+}
+
+func (s *SeriesResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config SeriesResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Path.IsNull() || config.Path.IsUnknown() || config.RootFolderPath.IsNull() || config.RootFolderPath.IsUnknown() {
+		return
+	}
+	if !samePath(parentFolder(config.Path.ValueString()), config.RootFolderPath.ValueString()) {
+		response.Diagnostics.AddAttributeError(path.Root("path"), "Path outside of the root folder",
+			fmt.Sprintf("path %q must be a folder directly inside root_folder_path %q.", config.Path.ValueString(), config.RootFolderPath.ValueString()))
+	}
+}
+
+// ModifyPlan keeps path and root_folder_path consistent on update when only one of them is configured:
+// a new root folder moves the series folder into it, a new path moves the series to its parent folder.
+// It then checks the planned values against the live Sonarr instance.
+func (s *SeriesResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan SeriesResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state *SeriesResourceModel
+	if !request.State.Raw.IsNull() {
+		state = &SeriesResourceModel{}
+		response.Diagnostics.Append(request.State.Get(ctx, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		switch {
+		case config.Path.IsNull() && !plan.RootFolderPath.IsUnknown() &&
+			!samePath(plan.RootFolderPath.ValueString(), state.RootFolderPath.ValueString()):
+			plan.Path = types.StringValue(seriesPathInRootFolder(state.Path.ValueString(), plan.RootFolderPath.ValueString()))
+			response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+		case config.RootFolderPath.IsNull() && !plan.Path.IsUnknown() &&
+			!samePath(plan.Path.ValueString(), state.Path.ValueString()):
+			plan.RootFolderPath = types.StringValue(parentFolder(plan.Path.ValueString()))
+			response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+		}
+	}
+
+	// The provider is not configured yet when its own configuration is unknown
+	if s.clients == nil {
+		return
+	}
+	client, diags := s.clients.Get(plan.Instance)
+	if diags.HasError() {
+		// An unknown instance is reported by apply, the plan may still be computing it
+		return
+	}
+	response.Diagnostics.Append(s.validatePlan(ctx, client, &plan, state)...)
+}
+
+// validatePlan checks the known planned values that changed against Sonarr, so mistakes surface
+// during plan instead of as a 400 halfway through an apply. state is nil when the series is created.
+func (s *SeriesResource) validatePlan(ctx context.Context, client *sonarr.Client, plan, state *SeriesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.QualityProfileId.IsUnknown() && (state == nil || !plan.QualityProfileId.Equal(state.QualityProfileId)) {
+		profiles, err := client.GetQualityProfiles(ctx)
+		if err != nil {
+			diags.AddError("Error getting quality profiles", err.Error())
+			return diags
+		}
+		if !slices.ContainsFunc(profiles, func(p sonarr.QualityProfile) bool { return p.Id == plan.QualityProfileId.ValueInt32() }) {
+			names := make([]string, 0, len(profiles))
+			for _, profile := range profiles {
+				names = append(names, fmt.Sprintf("%d (%s)", profile.Id, profile.Name))
+			}
+			diags.AddAttributeError(path.Root("quality_profile_id"), "Unknown quality profile",
+				fmt.Sprintf("Sonarr has no quality profile with ID %d. Available profiles: %s.",
+					plan.QualityProfileId.ValueInt32(), strings.Join(names, ", ")))
+		}
+	}
+
+	rootFolder := plan.RootFolderPath
+	if rootFolder.IsUnknown() && !plan.Path.IsUnknown() && !plan.Path.IsNull() {
+		rootFolder = types.StringValue(parentFolder(plan.Path.ValueString()))
+	}
+	if !rootFolder.IsUnknown() && !rootFolder.IsNull() && (state == nil || !samePath(rootFolder.ValueString(), state.RootFolderPath.ValueString())) {
+		folders, err := client.GetRootFolders(ctx)
+		if err != nil {
+			diags.AddError("Error getting root folders", err.Error())
+			return diags
+		}
+		index := slices.IndexFunc(folders, func(f sonarr.RootFolder) bool { return samePath(f.Path, rootFolder.ValueString()) })
+		switch {
+		case index < 0:
+			paths := make([]string, 0, len(folders))
+			for _, folder := range folders {
+				paths = append(paths, folder.Path)
+			}
+			diags.AddAttributeError(path.Root("root_folder_path"), "Unregistered root folder",
+				fmt.Sprintf("%q is not a root folder in Sonarr. Registered root folders: %s.", rootFolder.ValueString(), strings.Join(paths, ", ")))
+		case !folders[index].Accessible:
+			diags.AddAttributeError(path.Root("root_folder_path"), "Inaccessible root folder",
+				fmt.Sprintf("Sonarr can't access the root folder %q. Check that it is mounted and writable by Sonarr.", rootFolder.ValueString()))
+		}
+	}
+
+	if state != nil || plan.TvdbId.IsUnknown() {
+		return diags
+	}
+	tvdbId := plan.TvdbId.ValueInt32()
+
+	results, err := client.LookupSeries(ctx, fmt.Sprintf("tvdb:%d", tvdbId))
+	if err != nil {
+		diags.AddError("Error looking up series", err.Error())
+		return diags
+	}
+	if !slices.ContainsFunc(results, func(r sonarr.SeriesLookup) bool { return r.TvdbId == tvdbId }) {
+		diags.AddAttributeError(path.Root("tvdb_id"), "Unknown TVDB ID",
+			fmt.Sprintf("Sonarr's lookup found no series with TVDB ID %d.", tvdbId))
+	}
+
+	allSeries, err := client.GetAllSeries(ctx)
+	if err != nil {
+		diags.AddError("Error getting series", err.Error())
+		return diags
+	}
+	for _, series := range allSeries {
+		if series.TvdbID != tvdbId {
+			continue
+		}
+		if plan.AdoptExisting.ValueBool() {
+			tflog.Info(ctx, "Series already in the library will be adopted", map[string]any{"id": series.Id, "tvdb_id": tvdbId})
+			break
+		}
+		diags.AddAttributeError(path.Root("tvdb_id"), "Series already in library",
+			fmt.Sprintf("%s (TVDB ID %d) is already in Sonarr as series ID %d. Set adopt_existing to take ownership of it.",
+				series.Title, tvdbId, series.Id))
+		break
+	}
+	return diags
+}
+
+// fromSeries sets the attributes Sonarr may fill in or change from the series.
+func (m *SeriesResourceModel) fromSeries(series *sonarr.Series) {
+	m.Title = types.StringValue(series.Title)
+	m.RootFolderPath = types.StringValue(seriesRootFolder(series))
+	m.Path = types.StringValue(series.Path)
+	m.Monitored = types.BoolValue(series.Monitored)
 }
 
 func NewSeriesResource() resource.Resource {
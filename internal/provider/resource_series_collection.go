@@ -0,0 +1,609 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// SeriesCollectionResource owns many series at once.
+// It reads the library with a single GetAllSeries call, adds the series missing from it and
+// applies changes through the series editor, grouping series that need the same change into
+// one request. Series removed from the collection are only deleted with delete_on_remove.
+type SeriesCollectionResource struct {
+	clients *Clients
+}
+
+var _ resource.ResourceWithModifyPlan = &SeriesCollectionResource{}
+
+type SeriesCollectionResourceModel struct {
+	Instance       types.String                         `tfsdk:"instance"`
+	ID             types.String                         `tfsdk:"id"`
+	MoveFiles      types.Bool                           `tfsdk:"move_files"`
+	DeleteOnRemove types.Bool                           `tfsdk:"delete_on_remove"`
+	Series         map[string]SeriesCollectionItemModel `tfsdk:"series"`
+	Timeouts       timeouts.Value                       `tfsdk:"timeouts"`
+}
+
+// SeriesCollectionItemModel holds the settings of one series of the collection, keyed by TVDB ID.
+type SeriesCollectionItemModel struct {
+	ID               types.Int32  `tfsdk:"id"`
+	Title            types.String `tfsdk:"title"`
+	Path             types.String `tfsdk:"path"`
+	RootFolderPath   types.String `tfsdk:"root_folder_path"`
+	QualityProfileId types.Int32  `tfsdk:"quality_profile_id"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+	Tags             types.Set    `tfsdk:"tags"`
+}
+
+func (s *SeriesCollectionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_series_collection"
+}
+
+func (s *SeriesCollectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Resource owning many series of the Sonarr library. Series missing from the library are added, " +
+			"changes are applied through the bulk series editor. Series removed from the collection, or all of them when it is destroyed, " +
+			"are left in the library unless delete_on_remove is set.",
+		Attributes: map[string]schema.Attribute{
+			"instance": resourceInstanceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"move_files": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Move the series folders on disk when root_folder_path changes, instead of only repointing the series",
+			},
+			"delete_on_remove": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Delete series from Sonarr when they are removed from the collection or the collection is destroyed. " +
+					"Their files stay on disk. Without it they are only no longer managed.",
+			},
+			"series": schema.MapNestedAttribute{
+				Required: true,
+				Description: "Series keyed by TVDB ID. Unset settings of series already in the library are left as they are in Sonarr. " +
+					"Series missing from the library are added, which requires root_folder_path and quality_profile_id.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9]*$`), "must be a TVDB ID")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Computed:    true,
+							Description: "ID of the series in Sonarr",
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Title of the series",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Full path of the series folder",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"root_folder_path": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Root folder the series lives in",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"quality_profile_id": schema.Int32Attribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "ID of the quality profile",
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"monitored": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the series is monitored. Series added by the collection are monitored unless it is false.",
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"tags": schema.SetAttribute{
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.Int32Type,
+							Description: "IDs of the tags of the series. Replaces the existing tags when set.",
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (s *SeriesCollectionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan SeriesCollectionResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := s.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	response.Diagnostics.Append(s.apply(ctx, client, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(seriesCollectionID(plan.Instance))
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (s *SeriesCollectionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state SeriesCollectionResourceModel
+	diags := request.State.Get(ctx, &state)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	client, diags := s.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	allSeries, err := client.GetAllSeries(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Error getting series", err.Error())
+		return
+	}
+	byTvdbId := seriesByTvdbId(allSeries)
+
+	for key, item := range state.Series {
+		series, ok := byTvdbId[key]
+		if !ok {
+			// Dropping the series from the state makes the next apply add it again.
+			tflog.Warn(ctx, "Series of the collection no longer exists", map[string]any{"tvdb_id": key})
+			delete(state.Series, key)
+			continue
+		}
+		response.Diagnostics.Append(item.fromSeries(series)...)
+		state.Series[key] = item
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (s *SeriesCollectionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state SeriesCollectionResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := s.clients.Get(plan.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	response.Diagnostics.Append(s.apply(ctx, client, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DeleteOnRemove.ValueBool() {
+		removed := map[string]SeriesCollectionItemModel{}
+		for key, item := range state.Series {
+			if _, ok := plan.Series[key]; !ok {
+				removed[key] = item
+			}
+		}
+		response.Diagnostics.Append(deleteCollectionSeries(ctx, client, removed)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = state.ID
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (s *SeriesCollectionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state SeriesCollectionResourceModel
+	diags := request.State.Get(ctx, &state)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !state.DeleteOnRemove.ValueBool() {
+		// The series stay in the library, they are only no longer managed.
+		return
+	}
+
+	client, diags := s.clients.Get(state.Instance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	response.Diagnostics.Append(deleteCollectionSeries(ctx, client, state.Series)...)
+}
+
+// ModifyPlan marks the path of a series unknown when its root folder changes,
+// as Sonarr picks the new path when it moves the series.
+func (s *SeriesCollectionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() || request.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SeriesCollectionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	changed := false
+	for key, item := range plan.Series {
+		prior, ok := state.Series[key]
+		if !ok || item.RootFolderPath.IsUnknown() || samePath(item.RootFolderPath.ValueString(), prior.RootFolderPath.ValueString()) {
+			continue
+		}
+		item.Path = types.StringUnknown()
+		plan.Series[key] = item
+		changed = true
+	}
+	if changed {
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+	}
+}
+
+func (s *SeriesCollectionResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	clients, ok := request.ProviderData.(*Clients)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Clients, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+		return
+	}
+	s.clients = clients
+}
+
+// apply brings the library in line with the planned collection and fills in the computed attributes.
+// Series needing the same change are sent to the series editor together. Problems with a single
+// series are reported on its map key.
+func (s *SeriesCollectionResource) apply(ctx context.Context, client *sonarr.Client, plan *SeriesCollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	allSeries, err := client.GetAllSeries(ctx)
+	if err != nil {
+		diags.AddError("Error getting series", err.Error())
+		return diags
+	}
+	byTvdbId := seriesByTvdbId(allSeries)
+
+	// Edits keyed by their JSON encoding without series IDs, so identical changes share a request.
+	edits := map[string]*sonarr.SeriesEditor{}
+	editKeys := map[string][]string{}
+
+	keys := slices.Sorted(maps.Keys(plan.Series))
+	for _, key := range keys {
+		itemPath := path.Root("series").AtMapKey(key)
+
+		item := plan.Series[key]
+
+		series, ok := byTvdbId[key]
+		if !ok {
+			added, d := addCollectionSeries(ctx, client, key, item)
+			for _, e := range d {
+				diags.AddAttributeError(itemPath, e.Summary(), e.Detail())
+			}
+			if added != nil {
+				byTvdbId[key] = added
+			}
+			continue
+		}
+
+		edit, d := item.edit(ctx, series)
+		diags.Append(d...)
+		if d.HasError() || edit == nil {
+			continue
+		}
+		edit.MoveFiles = edit.RootFolderPath != "" && plan.MoveFiles.ValueBool()
+
+		signature, err := json.Marshal(edit)
+		if err != nil {
+			diags.AddAttributeError(itemPath, "Error encoding series edit", err.Error())
+			continue
+		}
+		if existing, ok := edits[string(signature)]; ok {
+			edit = existing
+		} else {
+			edits[string(signature)] = edit
+		}
+		edit.SeriesIds = append(edit.SeriesIds, series.Id)
+		editKeys[string(signature)] = append(editKeys[string(signature)], key)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, signature := range slices.Sorted(maps.Keys(edits)) {
+		tflog.Debug(ctx, "Editing series", map[string]any{"tvdb_ids": strings.Join(editKeys[signature], ","), "edit": signature})
+
+		edited, err := client.EditSeries(ctx, edits[signature])
+		if err != nil {
+			for _, key := range editKeys[signature] {
+				diags.AddAttributeError(path.Root("series").AtMapKey(key), "Error editing series", err.Error())
+			}
+			continue
+		}
+		maps.Copy(byTvdbId, seriesByTvdbId(edited))
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, key := range keys {
+		item := plan.Series[key]
+		diags.Append(item.fromSeries(byTvdbId[key])...)
+		plan.Series[key] = item
+	}
+	return diags
+}
+
+// addCollectionSeries adds the series with the TVDB ID key to the library with the settings of the item.
+func addCollectionSeries(ctx context.Context, client *sonarr.Client, key string, item SeriesCollectionItemModel) (*sonarr.Series, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if item.RootFolderPath.ValueString() == "" || item.QualityProfileId.IsNull() || item.QualityProfileId.IsUnknown() {
+		diags.AddError("Series not in library",
+			fmt.Sprintf("Sonarr has no series with TVDB ID %s. Set root_folder_path and quality_profile_id to add it.", key))
+		return nil, diags
+	}
+
+	// Sonarr requires the title of a new series, which is only known to the TVDB lookup
+	results, err := client.LookupSeries(ctx, "tvdb:"+key)
+	if err != nil {
+		diags.AddError("Error looking up series", err.Error())
+		return nil, diags
+	}
+	index := slices.IndexFunc(results, func(result sonarr.SeriesLookup) bool {
+		return strconv.Itoa(int(result.TvdbId)) == key
+	})
+	if index < 0 {
+		diags.AddError("Series not found", fmt.Sprintf("TVDB has no series with ID %s.", key))
+		return nil, diags
+	}
+
+	monitored := item.Monitored.IsNull() || item.Monitored.IsUnknown() || item.Monitored.ValueBool()
+	monitor := "all"
+	if !monitored {
+		monitor = "none"
+	}
+
+	series := &sonarr.Series{
+		Title:            results[index].Title,
+		TvdbID:           results[index].TvdbId,
+		QualityProfileId: item.QualityProfileId.ValueInt32(),
+		RootFolderPath:   item.RootFolderPath.ValueString(),
+		Monitored:        monitored,
+		AddOptions:       &sonarr.AddOptions{Monitor: monitor},
+	}
+	if !item.Tags.IsNull() && !item.Tags.IsUnknown() {
+		diags.Append(item.Tags.ElementsAs(ctx, &series.Tags, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	tflog.Info(ctx, "Adding series of the collection", map[string]any{"tvdb_id": key, "title": series.Title})
+	added, err := client.CreateSeries(ctx, series)
+	if err != nil {
+		diags.AddError("Error adding series", err.Error())
+		return nil, diags
+	}
+	return added, diags
+}
+
+// deleteCollectionSeries deletes the series of the items from the library in one request, keeping their files.
+func deleteCollectionSeries(ctx context.Context, client *sonarr.Client, items map[string]SeriesCollectionItemModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := make([]int32, 0, len(items))
+	for _, item := range items {
+		if !item.ID.IsNull() && !item.ID.IsUnknown() {
+			ids = append(ids, item.ID.ValueInt32())
+		}
+	}
+	if len(ids) == 0 {
+		return diags
+	}
+	slices.Sort(ids)
+
+	tflog.Info(ctx, "Deleting series removed from the collection", map[string]any{"count": len(ids)})
+	err := client.DeleteSeriesBatch(ctx, &sonarr.SeriesEditorDelete{SeriesIds: ids})
+	if err != nil {
+		diags.AddError("Error deleting series", err.Error())
+	}
+	return diags
+}
+
+// edit returns the series editor request bringing the series in line with the item,
+// nil if the series already matches.
+func (m SeriesCollectionItemModel) edit(ctx context.Context, series *sonarr.Series) (*sonarr.SeriesEditor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	edit := &sonarr.SeriesEditor{}
+	changed := false
+
+	if rootFolder := m.RootFolderPath.ValueString(); rootFolder != "" && !samePath(rootFolder, seriesRootFolder(series)) {
+		edit.RootFolderPath = rootFolder
+		changed = true
+	}
+	if !m.QualityProfileId.IsNull() && !m.QualityProfileId.IsUnknown() && m.QualityProfileId.ValueInt32() != series.QualityProfileId {
+		profile := m.QualityProfileId.ValueInt32()
+		edit.QualityProfileId = &profile
+		changed = true
+	}
+	if !m.Monitored.IsNull() && !m.Monitored.IsUnknown() && m.Monitored.ValueBool() != series.Monitored {
+		monitored := m.Monitored.ValueBool()
+		edit.Monitored = &monitored
+		changed = true
+	}
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		tags := []int32{}
+		diags.Append(m.Tags.ElementsAs(ctx, &tags, false)...)
+
+		current := slices.Sorted(slices.Values(series.Tags))
+		if !slices.Equal(slices.Sorted(slices.Values(tags)), current) {
+			edit.Tags = tags
+			edit.ApplyTags = sonarr.ApplyTagsReplace
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil, diags
+	}
+	return edit, diags
+}
+
+// fromSeries sets every attribute of the item from the series in Sonarr.
+// A root folder equal to Sonarr's but for trailing separators is kept as configured.
+func (m *SeriesCollectionItemModel) fromSeries(series *sonarr.Series) diag.Diagnostics {
+	m.ID = types.Int32Value(series.Id)
+	m.Title = types.StringValue(series.Title)
+	m.Path = types.StringValue(series.Path)
+	if rootFolder := seriesRootFolder(series); m.RootFolderPath.IsNull() || m.RootFolderPath.IsUnknown() || !samePath(m.RootFolderPath.ValueString(), rootFolder) {
+		m.RootFolderPath = types.StringValue(rootFolder)
+	}
+	m.QualityProfileId = types.Int32Value(series.QualityProfileId)
+	m.Monitored = types.BoolValue(series.Monitored)
+
+	tags := make([]attr.Value, 0, len(series.Tags))
+	for _, tag := range series.Tags {
+		tags = append(tags, types.Int32Value(tag))
+	}
+	var diags diag.Diagnostics
+	m.Tags, diags = types.SetValue(types.Int32Type, tags)
+	return diags
+}
+
+// seriesByTvdbId indexes series by their TVDB ID in the string form used as collection key.
+func seriesByTvdbId(series []sonarr.Series) map[string]*sonarr.Series {
+	byTvdbId := make(map[string]*sonarr.Series, len(series))
+	for i := range series {
+		byTvdbId[strconv.Itoa(int(series[i].TvdbID))] = &series[i]
+	}
+	return byTvdbId
+}
+
+// seriesCollectionID returns the ID of a collection, named after the instance it belongs to.
+func seriesCollectionID(instance types.String) string {
+	if instance.ValueString() == "" {
+		return "default"
+	}
+	return instance.ValueString()
+}
+
+// NewSeriesCollectionResource creates a new instance of the series collection resource.
+func NewSeriesCollectionResource() resource.Resource {
+	return &SeriesCollectionResource{}
+}
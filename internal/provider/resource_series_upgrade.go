@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SeriesResourceModelV0 is the state of sonarr_series at schema version 0, the schema
+// before versioning, when path held the root folder of the series.
+type SeriesResourceModelV0 struct {
+	ID               types.String     `tfsdk:"id"`
+	TvdbId           types.Int32      `tfsdk:"tvdb_id"`
+	Title            types.String     `tfsdk:"title"`
+	Path             types.String     `tfsdk:"path"`
+	Monitored        types.Bool       `tfsdk:"monitored"`
+	QualityProfileId types.Int32      `tfsdk:"quality_profile"`
+	AddOptions       *AddOptionsModel `tfsdk:"add_options"`
+}
+
+// seriesSchemaV0 is the sonarr_series schema at version 0.
+func seriesSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":              schema.StringAttribute{Computed: true},
+			"tvdb_id":         schema.Int32Attribute{Required: true},
+			"title":           schema.StringAttribute{Optional: true, Computed: true},
+			"path":            schema.StringAttribute{Required: true},
+			"monitored":       schema.BoolAttribute{Optional: true, Computed: true},
+			"quality_profile": schema.Int32Attribute{Required: true},
+			"add_options": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"monitor": schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}
+}
+
+func (s *SeriesResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   seriesSchemaV0(),
+			StateUpgrader: upgradeSeriesStateV0,
+		},
+	}
+}
+
+// upgradeSeriesStateV0 moves the root folder from path to root_folder_path, converts the ID
+// to a number and renames quality_profile to quality_profile_id. The full series path is
+// unknown to version 0 and filled in by the next refresh; attributes added since get their defaults.
+func upgradeSeriesStateV0(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+	var prior SeriesResourceModelV0
+	response.Diagnostics.Append(request.State.Get(ctx, &prior)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id := types.Int64Null()
+	if prior.ID.ValueString() != "" {
+		value, err := strconv.ParseInt(prior.ID.ValueString(), 10, 64)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("id"), "Error upgrading series state",
+				fmt.Sprintf("Unable to convert the series ID %q to a number: %s", prior.ID.ValueString(), err.Error()))
+			return
+		}
+		id = types.Int64Value(value)
+	}
+
+	upgraded := SeriesResourceModel{
+		Instance:         types.StringNull(),
+		ID:               id,
+		TvdbId:           prior.TvdbId,
+		Title:            prior.Title,
+		RootFolderPath:   prior.Path,
+		Path:             types.StringNull(),
+		Monitored:        prior.Monitored,
+		QualityProfileId: prior.QualityProfileId,
+		MoveFiles:        types.BoolValue(false),
+		AdoptExisting:    types.BoolValue(false),
+		WaitForRefresh:   types.BoolValue(false),
+		WaitForSearch:    types.BoolValue(false),
+		AddOptions:       prior.AddOptions,
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(timeouts.BlockAll(ctx).Type().(timeouts.Type).AttrTypes),
+		},
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, upgraded)...)
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// upgradeSeriesState runs raw sonarr_series state of the given schema version through the
+// provider's UpgradeResourceState, the way Terraform does on the first plan after an upgrade.
+func upgradeSeriesState(t *testing.T, version int64, rawState string) (*SeriesResourceModel, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	response, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "sonarr_series",
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatalf("upgrading state: %s", err)
+	}
+	if len(response.Diagnostics) > 0 {
+		return nil, response.Diagnostics
+	}
+
+	var schemaResponse resource.SchemaResponse
+	NewSeriesResource().Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	raw, err := response.UpgradedState.Unmarshal(schemaResponse.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("decoding upgraded state: %s", err)
+	}
+
+	var model SeriesResourceModel
+	diags := tfsdk.State{Schema: schemaResponse.Schema, Raw: raw}.Get(ctx, &model)
+	if diags.HasError() {
+		t.Fatalf("reading upgraded state: %v", diags)
+	}
+	return &model, nil
+}
+
+func diagnosticsString(diags []*tfprotov6.Diagnostic) string {
+	var b strings.Builder
+	for _, d := range diags {
+		fmt.Fprintf(&b, "%s: %s; ", d.Summary, d.Detail)
+	}
+	return b.String()
+}
+
+func TestSeriesResourceUpgradeStateV0(t *testing.T) {
+	model, diags := upgradeSeriesState(t, 0, `{
+		"id": "42",
+		"tvdb_id": 289590,
+		"title": "Mr. Robot",
+		"path": "/media/series",
+		"monitored": true,
+		"quality_profile": 3,
+		"add_options": {"monitor": "all"}
+	}`)
+	if diags != nil {
+		t.Fatalf("unexpected diagnostics: %s", diagnosticsString(diags))
+	}
+
+	checks := []struct {
+		name      string
+		got, want any
+	}{
+		{"id", model.ID, types.Int64Value(42)},
+		{"tvdb_id", model.TvdbId, types.Int32Value(289590)},
+		{"title", model.Title, types.StringValue("Mr. Robot")},
+		{"root_folder_path", model.RootFolderPath, types.StringValue("/media/series")},
+		{"path", model.Path, types.StringNull()},
+		{"monitored", model.Monitored, types.BoolValue(true)},
+		{"quality_profile_id", model.QualityProfileId, types.Int32Value(3)},
+		{"move_files", model.MoveFiles, types.BoolValue(false)},
+		{"instance", model.Instance, types.StringNull()},
+	}
+	for _, check := range checks {
+		if check.got != check.want {
+			t.Errorf("%s = %v, want %v", check.name, check.got, check.want)
+		}
+	}
+	if model.AddOptions == nil || model.AddOptions.Monitor != types.StringValue("all") {
+		t.Errorf("add_options = %v, want monitor all", model.AddOptions)
+	}
+}
+
+func TestSeriesResourceUpgradeStateV0WithoutOptionalAttributes(t *testing.T) {
+	model, diags := upgradeSeriesState(t, 0, `{
+		"id": "7",
+		"tvdb_id": 81189,
+		"title": "Breaking Bad",
+		"path": "/tv",
+		"monitored": false,
+		"quality_profile": 1,
+		"add_options": null
+	}`)
+	if diags != nil {
+		t.Fatalf("unexpected diagnostics: %s", diagnosticsString(diags))
+	}
+
+	if model.ID != types.Int64Value(7) {
+		t.Errorf("id = %v, want 7", model.ID)
+	}
+	if model.RootFolderPath != types.StringValue("/tv") {
+		t.Errorf("root_folder_path = %v, want /tv", model.RootFolderPath)
+	}
+	if model.AddOptions != nil {
+		t.Errorf("add_options = %v, want null", model.AddOptions)
+	}
+}
+
+// TestSeriesResourceUpgradeStateBaseline upgrades the state exactly as the first release,
+// without a schema version, wrote it: every attribute present and title filled in by Sonarr.
+func TestSeriesResourceUpgradeStateBaseline(t *testing.T) {
+	model, diags := upgradeSeriesState(t, 0, `{"add_options":{"monitor":"future"},"id":"15","monitored":true,"path":"D:\\TV","quality_profile":6,"title":"The Expanse","tvdb_id":280619}`)
+	if diags != nil {
+		t.Fatalf("unexpected diagnostics: %s", diagnosticsString(diags))
+	}
+
+	checks := []struct {
+		name      string
+		got, want any
+	}{
+		{"instance", model.Instance, types.StringNull()},
+		{"id", model.ID, types.Int64Value(15)},
+		{"tvdb_id", model.TvdbId, types.Int32Value(280619)},
+		{"title", model.Title, types.StringValue("The Expanse")},
+		{"root_folder_path", model.RootFolderPath, types.StringValue(`D:\TV`)},
+		{"path", model.Path, types.StringNull()},
+		{"monitored", model.Monitored, types.BoolValue(true)},
+		{"quality_profile_id", model.QualityProfileId, types.Int32Value(6)},
+		{"move_files", model.MoveFiles, types.BoolValue(false)},
+		{"adopt_existing", model.AdoptExisting, types.BoolValue(false)},
+		{"wait_for_refresh", model.WaitForRefresh, types.BoolValue(false)},
+		{"wait_for_search", model.WaitForSearch, types.BoolValue(false)},
+	}
+	for _, check := range checks {
+		if check.got != check.want {
+			t.Errorf("%s = %v, want %v", check.name, check.got, check.want)
+		}
+	}
+	if model.AddOptions == nil || model.AddOptions.Monitor != types.StringValue("future") {
+		t.Errorf("add_options = %v, want monitor future", model.AddOptions)
+	}
+	if !model.Timeouts.IsNull() {
+		t.Errorf("timeouts = %v, want null", model.Timeouts)
+	}
+}
+
+// TestSeriesSchemaV0MatchesBaseline guards the prior schema against attributes the first
+// release never had, which would make its states fail to decode.
+func TestSeriesSchemaV0MatchesBaseline(t *testing.T) {
+	ctx := context.Background()
+	want := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":              tftypes.String,
+		"tvdb_id":         tftypes.Number,
+		"title":           tftypes.String,
+		"path":            tftypes.String,
+		"monitored":       tftypes.Bool,
+		"quality_profile": tftypes.Number,
+		"add_options":     tftypes.Object{AttributeTypes: map[string]tftypes.Type{"monitor": tftypes.String}},
+	}}
+
+	if got := seriesSchemaV0().Type().TerraformType(ctx); !got.Equal(want) {
+		t.Errorf("version 0 schema = %s, want %s", got, want)
+	}
+}
+
+func TestSeriesResourceUpgradeStateInvalidID(t *testing.T) {
+	_, diags := upgradeSeriesState(t, 0, `{
+		"id": "not-a-number",
+		"tvdb_id": 289590,
+		"title": "Mr. Robot",
+		"path": "/media/series",
+		"monitored": true,
+		"quality_profile": 1,
+		"add_options": null
+	}`)
+	if len(diags) == 0 {
+		t.Fatal("expected an error diagnostic for a non-numeric ID")
+	}
+	if diags[0].Severity != tfprotov6.DiagnosticSeverityError || diags[0].Summary != "Error upgrading series state" {
+		t.Errorf("unexpected diagnostic: %s: %s", diags[0].Summary, diags[0].Detail)
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/oleksii-kalinin/terraform-provider-sonarr/pkg/sonarr"
+)
+
+// seriesRootFolder returns the root folder of the series. Sonarr omits rootFolderPath on some
+// endpoints, in which case it is the parent folder of the series path.
+func seriesRootFolder(series *sonarr.Series) string {
+	if series.RootFolderPath != "" {
+		return series.RootFolderPath
+	}
+	return parentFolder(series.Path)
+}
+
+// parentFolder returns the parent of a folder path using either path separator.
+func parentFolder(folder string) string {
+	trimmed := strings.TrimRight(folder, `/\`)
+	i := strings.LastIndexAny(trimmed, `/\`)
+	if i < 0 {
+		return ""
+	}
+	return trimmed[:i]
+}
+
+// samePath compares two folder paths ignoring trailing separators.
+func samePath(a, b string) bool {
+	return strings.TrimRight(a, `/\`) == strings.TrimRight(b, `/\`)
+}
+
+// seriesPathInRootFolder returns the path of the series folder moved to another root folder,
+// keeping the folder name and the path separator of the current path.
+func seriesPathInRootFolder(currentPath, rootFolder string) string {
+	separator := "/"
+	if strings.Contains(currentPath, `\`) {
+		separator = `\`
+	}
+	trimmed := strings.TrimRight(currentPath, `/\`)
+	folder := trimmed[strings.LastIndexAny(trimmed, `/\`)+1:]
+	return strings.TrimRight(rootFolder, `/\`) + separator + folder
+}
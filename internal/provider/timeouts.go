@@ -0,0 +1,11 @@
+package provider
+
+import "time"
+
+// Default operation timeouts of the resources, overridable with their timeouts block.
+const (
+	defaultCreateTimeout = 5 * time.Minute
+	defaultReadTimeout   = 2 * time.Minute
+	defaultUpdateTimeout = 5 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
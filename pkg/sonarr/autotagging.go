@@ -0,0 +1,168 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+)
+
+// GetAutoTagging retrieves a single auto tagging rule by ID.
+// Returns nil without an error if the rule does not exist.
+func (c *Client) GetAutoTagging(ctx context.Context, id int) (*AutoTagging, error) {
+	tagging := AutoTagging{}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "autotagging", strconv.Itoa(id))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, nil // No such resource
+	case http.StatusOK:
+		break
+	default:
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&tagging)
+	if err != nil {
+		return nil, err
+	}
+	return &tagging, nil
+}
+
+// CreateAutoTagging creates a new auto tagging rule.
+func (c *Client) CreateAutoTagging(ctx context.Context, tagging *AutoTagging) (*AutoTagging, error) {
+	jsonBytes, err := json.Marshal(tagging)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "autotagging")
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusCreated, http.StatusOK:
+		break
+	default:
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("API error: %d - %s", res.StatusCode, string(bodyBytes))
+	}
+
+	var result AutoTagging
+	decoder := json.NewDecoder(res.Body)
+	err = decoder.Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateAutoTagging replaces an existing auto tagging rule.
+func (c *Client) UpdateAutoTagging(ctx context.Context, tagging *AutoTagging) (*AutoTagging, error) {
+	if tagging == nil {
+		return nil, fmt.Errorf("auto tagging can't be nil")
+	}
+	jsonBytes, err := json.Marshal(tagging)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "autotagging", strconv.Itoa(int(tagging.Id)))
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", u.String(), bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusAccepted, http.StatusOK:
+		var result AutoTagging
+		bodyBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(bodyBytes) == 0 {
+			return tagging, nil
+		}
+		err = json.Unmarshal(bodyBytes, &result)
+		if err != nil {
+			return nil, err
+		}
+		return &result, nil
+	default:
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("API Error: %d - %s", res.StatusCode, string(bodyBytes))
+	}
+}
+
+// DeleteAutoTagging removes an auto tagging rule. A missing rule is not an error.
+func (c *Client) DeleteAutoTagging(ctx context.Context, id int) error {
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return err
+	}
+	u = u.JoinPath("api", "v3", "autotagging", strconv.Itoa(id))
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusNotFound, http.StatusNoContent, http.StatusOK:
+		return nil
+	default:
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("DELETE failed: %d %s - %s",
+			res.StatusCode, res.Status, string(body))
+	}
+}
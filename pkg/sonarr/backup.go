@@ -0,0 +1,104 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+)
+
+// Backup types reported by Sonarr.
+const (
+	BackupTypeScheduled = "scheduled"
+	BackupTypeManual    = "manual"
+	BackupTypeUpdate    = "update"
+)
+
+// GetBackups retrieves the backup archives kept by Sonarr.
+func (c *Client) GetBackups(ctx context.Context) ([]Backup, error) {
+	var backups []Backup
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "system", "backup")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&backups)
+	if err != nil {
+		return nil, err
+	}
+	return backups, nil
+}
+
+// DeleteBackup removes a backup archive.
+func (c *Client) DeleteBackup(ctx context.Context, id int) error {
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return err
+	}
+	u = u.JoinPath("api", "v3", "system", "backup", strconv.Itoa(id))
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("API error: status code %d", res.StatusCode)
+	}
+}
+
+// DownloadBackup writes the archive of the backup to w and returns the number of bytes written.
+func (c *Client) DownloadBackup(ctx context.Context, backup *Backup, w io.Writer) (int64, error) {
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return 0, err
+	}
+	u = u.JoinPath(backup.Path)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return 0, err
+	}
+	defer closeBody(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("API error: status code %d", res.StatusCode)
+	}
+
+	return io.Copy(w, res.Body)
+}
@@ -1,39 +1,107 @@
 package sonarr
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"io"
 	"log"
 	"net/http"
 	"time"
 )
 
+// ErrUnauthorized is returned when Sonarr rejects the API key.
+var ErrUnauthorized = errors.New("sonarr rejected the API key (401 Unauthorized)")
+
 type Client struct {
 	BaseURL    string
 	ApiKey     string
 	HttpClient *http.Client
+
+	// Version is the Sonarr version detected by DetectVersion, empty if it was never detected.
+	Version string
+
+	// seriesCache is the GetAllSeries cache set up by WithSeriesCache, nil if disabled.
+	seriesCache *seriesCache
+
+	// requestTimeout bounds requests whose context has no deadline, see WithRequestTimeout.
+	requestTimeout time.Duration
 }
 
-func NewClient(url, key string) *Client {
-	timeout := 10 * time.Second // Hardcode for now
+// DefaultRequestTimeout bounds requests whose context has no deadline unless WithRequestTimeout changes it.
+const DefaultRequestTimeout = 30 * time.Second
+
+// ClientOption customizes a Client created by NewClient.
+type ClientOption func(*Client)
 
-	return &Client{
-		BaseURL: url,
-		ApiKey:  key,
-		HttpClient: &http.Client{
-			Timeout: timeout,
-		},
+// WithTLSConfig sets the TLS configuration used for HTTPS connections to Sonarr.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config
+		c.HttpClient.Transport = transport
 	}
 }
 
+// WithRequestTimeout bounds requests whose context has no deadline, e.g. from data sources.
+// Requests made under a deadline, like the timeouts of a resource, are only bounded by it.
+// A timeout of zero leaves such requests unbounded.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
+}
+
+// NewClient creates a client for the Sonarr instance at url. The HTTP client has no timeout of
+// its own: requests are bounded by their context, or DefaultRequestTimeout without a deadline.
+func NewClient(url, key string, opts ...ClientOption) *Client {
+	client := &Client{
+		BaseURL:        url,
+		ApiKey:         key,
+		HttpClient:     &http.Client{},
+		requestTimeout: DefaultRequestTimeout,
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
+}
+
 func (c *Client) doRequest(r *http.Request) (*http.Response, error) {
 	r.Header.Add("X-Api-Key", c.ApiKey)
 	r.Header.Add("Content-Type", "application/json")
 
+	cancel := context.CancelFunc(func() {})
+	if _, ok := r.Context().Deadline(); !ok && c.requestTimeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(r.Context(), c.requestTimeout)
+		r = r.WithContext(ctx)
+	}
+
 	res, err := c.HttpClient.Do(r)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
-	return res, err
+	if res.StatusCode == http.StatusUnauthorized {
+		closeBody(res.Body)
+		cancel()
+		return nil, ErrUnauthorized
+	}
+	// The timeout covers reading the body, so it is released when the caller closes it.
+	res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelOnClose releases the request timeout of a response when its body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
 }
 
 // closeBody closes an io.ReadCloser and logs any error.
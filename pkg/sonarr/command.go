@@ -0,0 +1,187 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	url2 "net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Command statuses reported by Sonarr.
+const (
+	CommandStatusQueued    = "queued"
+	CommandStatusStarted   = "started"
+	CommandStatusCompleted = "completed"
+	CommandStatusFailed    = "failed"
+	CommandStatusAborted   = "aborted"
+	CommandStatusCancelled = "cancelled"
+	CommandStatusOrphaned  = "orphaned"
+)
+
+// Finished reports whether the command has stopped running, successfully or not.
+func (c *Command) Finished() bool {
+	return c.Status != CommandStatusQueued && c.Status != CommandStatusStarted
+}
+
+// ForSeries reports whether the body of the command targets the series.
+func (c *Command) ForSeries(seriesId int32) bool {
+	if id, ok := c.Body["seriesId"].(float64); ok && int32(id) == seriesId {
+		return true
+	}
+	ids, _ := c.Body["seriesIds"].([]any)
+	return slices.ContainsFunc(ids, func(id any) bool {
+		value, ok := id.(float64)
+		return ok && int32(value) == seriesId
+	})
+}
+
+// GetCommands retrieves the queued, running and recently finished commands.
+func (c *Client) GetCommands(ctx context.Context) ([]Command, error) {
+	var commands []Command
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "command")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&commands)
+	if err != nil {
+		return nil, err
+	}
+	return commands, nil
+}
+
+// GetCommand retrieves a single command by ID.
+// Returns nil without an error if Sonarr no longer knows the command.
+func (c *Client) GetCommand(ctx context.Context, id int) (*Command, error) {
+	command := Command{}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "command", strconv.Itoa(id))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, nil // No such resource
+	case http.StatusOK:
+		break
+	default:
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&command)
+	if err != nil {
+		return nil, err
+	}
+	return &command, nil
+}
+
+// StartCommand queues the named command. The body holds the command specific
+// parameters, e.g. seriesId for SeriesSearch.
+func (c *Client) StartCommand(ctx context.Context, name string, body map[string]any) (*Command, error) {
+	request := map[string]any{}
+	maps.Copy(request, body)
+	request["name"] = name
+
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "command")
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusCreated, http.StatusOK:
+		break
+	default:
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("API error: %d - %s", res.StatusCode, string(bodyBytes))
+	}
+
+	var result Command
+	decoder := json.NewDecoder(res.Body)
+	err = decoder.Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// WaitForCommand polls the command every interval until it finishes or ctx is done.
+// It returns the finished command, whatever its status.
+func (c *Client) WaitForCommand(ctx context.Context, id int, interval time.Duration) (*Command, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		command, err := c.GetCommand(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if command == nil {
+			return nil, fmt.Errorf("command %d no longer exists", id)
+		}
+		if command.Finished() {
+			return command, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return command, fmt.Errorf("waiting for command %s (%d): %w", command.Name, id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package sonarr
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Sonarr's default ports, used when config.xml doesn't set one.
+const (
+	DefaultPort    = 8989
+	DefaultSslPort = 9898
+)
+
+// ConfigXML holds the connection settings of Sonarr's config.xml.
+type ConfigXML struct {
+	XMLName     xml.Name `xml:"Config"`
+	ApiKey      string   `xml:"ApiKey"`
+	Port        int32    `xml:"Port"`
+	SslPort     int32    `xml:"SslPort"`
+	EnableSsl   bool     `xml:"EnableSsl"`
+	UrlBase     string   `xml:"UrlBase"`
+	BindAddress string   `xml:"BindAddress"`
+}
+
+// ReadConfigXML reads and parses a Sonarr config.xml file.
+func ReadConfigXML(path string) (*ConfigXML, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := ConfigXML{}
+	err = xml.Unmarshal(data, &config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	if config.ApiKey == "" {
+		return nil, fmt.Errorf("%s doesn't contain an ApiKey", path)
+	}
+	return &config, nil
+}
+
+// HTTPPort returns the HTTP port, Sonarr's default if config.xml doesn't set one.
+func (c *ConfigXML) HTTPPort() int32 {
+	if c.Port == 0 {
+		return DefaultPort
+	}
+	return c.Port
+}
+
+// HTTPSPort returns the HTTPS port, Sonarr's default if config.xml doesn't set one.
+func (c *ConfigXML) HTTPSPort() int32 {
+	if c.SslPort == 0 {
+		return DefaultSslPort
+	}
+	return c.SslPort
+}
+
+// URL builds the base URL of the instance described by the config for the given host.
+// The HTTPS port is used when SSL is enabled.
+func (c *ConfigXML) URL(host string) string {
+	scheme, port := "http", c.HTTPPort()
+	if c.EnableSsl {
+		scheme, port = "https", c.HTTPSPort()
+	}
+
+	url := scheme + "://" + net.JoinHostPort(host, strconv.Itoa(int(port)))
+	if base := strings.Trim(c.UrlBase, "/"); base != "" {
+		url += "/" + base
+	}
+	return url
+}
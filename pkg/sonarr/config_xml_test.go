@@ -0,0 +1,98 @@
+package sonarr
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadConfigXML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.xml")
+	data := `<Config>
+  <BindAddress>*</BindAddress>
+  <Port>8990</Port>
+  <SslPort>9899</SslPort>
+  <EnableSsl>False</EnableSsl>
+  <ApiKey>0123456789abcdef</ApiKey>
+  <UrlBase>/sonarr</UrlBase>
+</Config>`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := ReadConfigXML(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if config.ApiKey != "0123456789abcdef" || config.Port != 8990 || config.SslPort != 9899 || config.UrlBase != "/sonarr" {
+		t.Errorf("ReadConfigXML() = %+v", *config)
+	}
+}
+
+func TestReadConfigXMLDefaultPorts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.xml")
+	if err := os.WriteFile(path, []byte("<Config><ApiKey>0123456789abcdef</ApiKey></Config>"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := ReadConfigXML(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := config.HTTPPort(); got != DefaultPort {
+		t.Errorf("HTTPPort() = %d, want %d", got, DefaultPort)
+	}
+	if got := config.HTTPSPort(); got != DefaultSslPort {
+		t.Errorf("HTTPSPort() = %d, want %d", got, DefaultSslPort)
+	}
+	if got, want := config.URL("localhost"), "http://localhost:8989"; got != want {
+		t.Errorf("URL() = %q, want %q", got, want)
+	}
+	config.EnableSsl = true
+	if got, want := config.URL("localhost"), "https://localhost:9898"; got != want {
+		t.Errorf("URL() with SSL = %q, want %q", got, want)
+	}
+}
+
+func TestReadConfigXMLErrors(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"invalid.xml":    "<Config><ApiKey>",
+		"no-api-key.xml": "<Config><Port>8989</Port></Config>",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"missing.xml", "invalid.xml", "no-api-key.xml"} {
+		if _, err := ReadConfigXML(filepath.Join(dir, name)); err == nil {
+			t.Errorf("ReadConfigXML(%q) succeeded, want an error", name)
+		}
+	}
+}
+
+func TestConfigXMLURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ConfigXML
+		host   string
+		want   string
+	}{
+		{"http", ConfigXML{Port: 8990, SslPort: 9899}, "localhost", "http://localhost:8990"},
+		{"https", ConfigXML{Port: 8990, SslPort: 9899, EnableSsl: true}, "localhost", "https://localhost:9899"},
+		{"url base", ConfigXML{Port: 8989, UrlBase: "/sonarr/"}, "sonarr.lan", "http://sonarr.lan:8989/sonarr"},
+		{"ipv6 host", ConfigXML{Port: 8989}, "::1", "http://[::1]:8989"},
+		{"missing port", ConfigXML{}, "localhost", "http://localhost:8989"},
+		{"missing ssl port", ConfigXML{Port: 8990, EnableSsl: true}, "localhost", "https://localhost:9898"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.URL(tt.host); got != tt.want {
+				t.Errorf("URL(%q) = %q, want %q", tt.host, got, tt.want)
+			}
+		})
+	}
+}
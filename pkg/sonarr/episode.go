@@ -0,0 +1,172 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+)
+
+// GetEpisodes retrieves the episodes of a series.
+// If seasonNumber is not nil only the episodes of that season are returned.
+func (c *Client) GetEpisodes(ctx context.Context, seriesId int, seasonNumber *int) ([]Episode, error) {
+	var episodes []Episode
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	u = u.JoinPath("api", "v3", "episode")
+	q := u.Query()
+	q.Set("seriesId", strconv.Itoa(seriesId))
+	if seasonNumber != nil {
+		q.Set("seasonNumber", strconv.Itoa(*seasonNumber))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&episodes)
+	if err != nil {
+		return nil, err
+	}
+	return episodes, nil
+}
+
+// GetEpisodesByIds retrieves the given episodes in a single call.
+// Episodes that don't exist are missing from the result.
+func (c *Client) GetEpisodesByIds(ctx context.Context, ids []int32) ([]Episode, error) {
+	var episodes []Episode
+	if len(ids) == 0 {
+		return episodes, nil
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	u = u.JoinPath("api", "v3", "episode")
+	q := u.Query()
+	for _, id := range ids {
+		q.Add("episodeIds", strconv.Itoa(int(id)))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&episodes)
+	if err != nil {
+		return nil, err
+	}
+	return episodes, nil
+}
+
+// GetEpisode retrieves a single episode by ID.
+// Returns nil without an error if the episode does not exist.
+func (c *Client) GetEpisode(ctx context.Context, id int) (*Episode, error) {
+	episode := Episode{}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "episode", strconv.Itoa(id))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, nil // No such resource
+	case http.StatusOK:
+		break
+	default:
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&episode)
+	if err != nil {
+		return nil, err
+	}
+	return &episode, nil
+}
+
+// MonitorEpisodes sets the monitored flag of the given episodes in a single call.
+func (c *Client) MonitorEpisodes(ctx context.Context, ids []int32, monitored bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	jsonBytes, err := json.Marshal(EpisodesMonitor{EpisodeIds: ids, Monitored: monitored})
+	if err != nil {
+		return err
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return err
+	}
+	u = u.JoinPath("api", "v3", "episode", "monitor")
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", u.String(), bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusAccepted, http.StatusOK, http.StatusNoContent:
+		return nil
+	default:
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("API Error: %d - %s", res.StatusCode, string(bodyBytes))
+	}
+}
@@ -0,0 +1,83 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	url2 "net/url"
+	"strconv"
+	"time"
+)
+
+// HistoryEventTypes maps the history event types, as reported in HistoryRecord.EventType,
+// to the values the history endpoint filters on.
+var HistoryEventTypes = map[string]int{
+	"grabbed":                1,
+	"seriesFolderImported":   2,
+	"downloadFolderImported": 3,
+	"downloadFailed":         4,
+	"episodeFileDeleted":     5,
+	"episodeFileRenamed":     6,
+	"downloadIgnored":        7,
+}
+
+// HistoryOptions filters the records returned by GetHistory.
+type HistoryOptions struct {
+	// SeriesIds only returns records of these series.
+	SeriesIds []int32
+	// EventTypes only returns records of these event types, see HistoryEventTypes.
+	EventTypes []string
+	// Since and Until only return records in this time range when they are not zero.
+	Since, Until time.Time
+	// Limit stops after this many records when it is greater than zero.
+	Limit int
+}
+
+// History streams the history records of the series and event types, most recent first. See Paginate.
+func (c *Client) History(ctx context.Context, seriesIds []int32, eventTypes []string) iter.Seq2[HistoryRecord, error] {
+	q := url2.Values{}
+	for _, id := range seriesIds {
+		q.Add("seriesIds", strconv.Itoa(int(id)))
+	}
+	for _, name := range eventTypes {
+		eventType, ok := HistoryEventTypes[name]
+		if !ok {
+			return func(yield func(HistoryRecord, error) bool) {
+				yield(HistoryRecord{}, fmt.Errorf("unknown history event type %q", name))
+			}
+		}
+		q.Add("eventType", strconv.Itoa(eventType))
+	}
+
+	return Paginate[HistoryRecord](ctx, c, PageRequest{
+		SortKey:       "date",
+		SortDirection: SortDescending,
+		Query:         q,
+	}, "history")
+}
+
+// GetHistory retrieves the history records matching the options, most recent first.
+// Pages are requested until the records are older than Since or Limit is reached.
+func (c *Client) GetHistory(ctx context.Context, options HistoryOptions) ([]HistoryRecord, error) {
+	var records []HistoryRecord
+	for record, err := range c.History(ctx, options.SeriesIds, options.EventTypes) {
+		if err != nil {
+			return nil, err
+		}
+		date, err := time.Parse(time.RFC3339, record.Date)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the date of history record %d: %w", record.Id, err)
+		}
+		if !options.Since.IsZero() && date.Before(options.Since) {
+			break
+		}
+		if !options.Until.IsZero() && date.After(options.Until) {
+			continue
+		}
+		records = append(records, record)
+		if options.Limit > 0 && len(records) >= options.Limit {
+			break
+		}
+	}
+	return records, nil
+}
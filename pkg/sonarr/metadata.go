@@ -0,0 +1,201 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+)
+
+// GetAllMetadata retrieves all metadata consumers configured in Sonarr.
+func (c *Client) GetAllMetadata(ctx context.Context) ([]Metadata, error) {
+	var metadata []Metadata
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "metadata")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&metadata)
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// GetMetadata retrieves a single metadata consumer by ID.
+// Returns nil without an error if the metadata consumer does not exist.
+func (c *Client) GetMetadata(ctx context.Context, id int) (*Metadata, error) {
+	metadata := Metadata{}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "metadata", strconv.Itoa(id))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, nil // No such resource
+	case http.StatusOK:
+		break
+	default:
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// CreateMetadata creates a new metadata consumer.
+func (c *Client) CreateMetadata(ctx context.Context, metadata *Metadata) (*Metadata, error) {
+	jsonBytes, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "metadata")
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusCreated, http.StatusOK:
+		break
+	default:
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("API error: %d - %s", res.StatusCode, string(bodyBytes))
+	}
+
+	var result Metadata
+	decoder := json.NewDecoder(res.Body)
+	err = decoder.Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateMetadata replaces an existing metadata consumer.
+func (c *Client) UpdateMetadata(ctx context.Context, metadata *Metadata) (*Metadata, error) {
+	if metadata == nil {
+		return nil, fmt.Errorf("metadata can't be nil")
+	}
+	jsonBytes, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "metadata", strconv.Itoa(int(metadata.Id)))
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", u.String(), bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusAccepted, http.StatusOK:
+		var result Metadata
+		bodyBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(bodyBytes) == 0 {
+			return metadata, nil
+		}
+		err = json.Unmarshal(bodyBytes, &result)
+		if err != nil {
+			return nil, err
+		}
+		return &result, nil
+	default:
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("API Error: %d - %s", res.StatusCode, string(bodyBytes))
+	}
+}
+
+// DeleteMetadata removes a metadata consumer. A missing consumer is not an error.
+func (c *Client) DeleteMetadata(ctx context.Context, id int) error {
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return err
+	}
+	u = u.JoinPath("api", "v3", "metadata", strconv.Itoa(id))
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusNotFound, http.StatusNoContent, http.StatusOK:
+		return nil
+	default:
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("DELETE failed: %d %s - %s",
+			res.StatusCode, res.Status, string(body))
+	}
+}
@@ -1,9 +1,62 @@
 package sonarr
 
 type SystemStatus struct {
-	AppName string `json:"appName"`
-	Version string `json:"version"`
-	OsName  string `json:"osName"`
+	AppName                string `json:"appName"`
+	InstanceName           string `json:"instanceName"`
+	Version                string `json:"version"`
+	BuildTime              string `json:"buildTime"`
+	IsDebug                bool   `json:"isDebug"`
+	IsProduction           bool   `json:"isProduction"`
+	IsAdmin                bool   `json:"isAdmin"`
+	IsUserInteractive      bool   `json:"isUserInteractive"`
+	StartupPath            string `json:"startupPath"`
+	AppData                string `json:"appData"`
+	OsName                 string `json:"osName"`
+	OsVersion              string `json:"osVersion"`
+	IsLinux                bool   `json:"isLinux"`
+	IsOsx                  bool   `json:"isOsx"`
+	IsWindows              bool   `json:"isWindows"`
+	IsDocker               bool   `json:"isDocker"`
+	Mode                   string `json:"mode"`
+	Branch                 string `json:"branch"`
+	Authentication         string `json:"authentication"`
+	DatabaseType           string `json:"databaseType"`
+	DatabaseVersion        string `json:"databaseVersion"`
+	MigrationVersion       int32  `json:"migrationVersion"`
+	UrlBase                string `json:"urlBase"`
+	RuntimeName            string `json:"runtimeName"`
+	RuntimeVersion         string `json:"runtimeVersion"`
+	StartTime              string `json:"startTime"`
+	PackageVersion         string `json:"packageVersion"`
+	PackageAuthor          string `json:"packageAuthor"`
+	PackageUpdateMechanism string `json:"packageUpdateMechanism"`
+}
+
+// HealthCheck is a single issue reported by Sonarr's health checks.
+type HealthCheck struct {
+	Source  string `json:"source"`
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	WikiUrl string `json:"wikiUrl"`
+}
+
+// DiskSpace describes the free and total space of a disk used by Sonarr.
+type DiskSpace struct {
+	Path       string `json:"path"`
+	Label      string `json:"label"`
+	FreeSpace  int64  `json:"freeSpace"`
+	TotalSpace int64  `json:"totalSpace"`
+}
+
+// Backup is a backup archive of the Sonarr database and configuration.
+type Backup struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+	// Path is the download path of the archive, relative to the Sonarr URL.
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size int64  `json:"size"`
+	Time string `json:"time"`
 }
 
 type ApiInfo struct {
@@ -18,6 +71,13 @@ type Series struct {
 	Monitored        bool        `json:"monitored"`
 	SeasonFolder     bool        `json:"seasonFolder"`
 	TvdbID           int32       `json:"tvdbId"`
+	ImdbId           string      `json:"imdbId,omitempty"`
+	TmdbId           int32       `json:"tmdbId,omitempty"`
+	Year             int32       `json:"year,omitempty"`
+	Status           string      `json:"status,omitempty"`
+	SeriesType       string      `json:"seriesType,omitempty"`
+	Tags             []int32     `json:"tags,omitempty"`
+	Seasons          []Season    `json:"seasons,omitempty"`
 	AddOptions       *AddOptions `json:"addOptions"`
 }
 
@@ -25,16 +85,225 @@ type AddOptions struct {
 	Monitor string `json:"monitor"`
 }
 
+// Apply modes of SeriesEditor.ApplyTags.
+const (
+	ApplyTagsAdd     = "add"
+	ApplyTagsRemove  = "remove"
+	ApplyTagsReplace = "replace"
+)
+
+// SeriesEditor is the request body of the series editor endpoint.
+// Nil fields are left unchanged on the selected series.
+type SeriesEditor struct {
+	SeriesIds        []int32 `json:"seriesIds"`
+	Monitored        *bool   `json:"monitored,omitempty"`
+	QualityProfileId *int32  `json:"qualityProfileId,omitempty"`
+	SeriesType       string  `json:"seriesType,omitempty"`
+	SeasonFolder     *bool   `json:"seasonFolder,omitempty"`
+	RootFolderPath   string  `json:"rootFolderPath,omitempty"`
+	Tags             []int32 `json:"tags"`
+	ApplyTags        string  `json:"applyTags,omitempty"`
+	MoveFiles        bool    `json:"moveFiles"`
+}
+
+// SeriesEditorDelete is the request body for deleting series through the series editor.
+type SeriesEditorDelete struct {
+	SeriesIds              []int32 `json:"seriesIds"`
+	DeleteFiles            bool    `json:"deleteFiles"`
+	AddImportListExclusion bool    `json:"addImportListExclusion"`
+}
+
 // SeriesLookup represents a series returned from Sonarr's TVDB lookup endpoint.
 type SeriesLookup struct {
-	Title      string `json:"title"`
-	SortTitle  string `json:"sortTitle"`
-	Status     string `json:"status"`
-	Overview   string `json:"overview"`
-	Network    string `json:"network"`
-	Year       int32  `json:"year"`
-	TvdbId     int32  `json:"tvdbId"`
-	ImdbId     string `json:"imdbId"`
-	Runtime    int32  `json:"runtime"`
-	SeasonCount int32 `json:"seasonCount"`
+	Title       string `json:"title"`
+	SortTitle   string `json:"sortTitle"`
+	Status      string `json:"status"`
+	Overview    string `json:"overview"`
+	Network     string `json:"network"`
+	Year        int32  `json:"year"`
+	TvdbId      int32  `json:"tvdbId"`
+	ImdbId      string `json:"imdbId"`
+	TmdbId      int32  `json:"tmdbId"`
+	Runtime     int32  `json:"runtime"`
+	SeasonCount int32  `json:"seasonCount"`
+
+	Images           []MediaCover `json:"images"`
+	Genres           []string     `json:"genres"`
+	Certification    string       `json:"certification"`
+	FirstAired       string       `json:"firstAired"`
+	OriginalLanguage *Language    `json:"originalLanguage"`
+	Ratings          *Ratings     `json:"ratings"`
+	Seasons          []Season     `json:"seasons"`
+}
+
+// MediaCover is an image (poster, fanart, banner, ...) of a series.
+type MediaCover struct {
+	CoverType string `json:"coverType"`
+	Url       string `json:"url"`
+	RemoteUrl string `json:"remoteUrl"`
+}
+
+// Language identifies a language known to Sonarr.
+type Language struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Ratings holds the aggregated rating of a series.
+type Ratings struct {
+	Votes int32   `json:"votes"`
+	Value float64 `json:"value"`
+}
+
+// Season describes a season of a series.
+type Season struct {
+	SeasonNumber int32             `json:"seasonNumber"`
+	Monitored    bool              `json:"monitored"`
+	Statistics   *SeasonStatistics `json:"statistics,omitempty"`
+}
+
+// SeasonStatistics holds the episode counters of a season.
+type SeasonStatistics struct {
+	EpisodeFileCount  int32 `json:"episodeFileCount"`
+	EpisodeCount      int32 `json:"episodeCount"`
+	TotalEpisodeCount int32 `json:"totalEpisodeCount"`
+	SizeOnDisk        int64 `json:"sizeOnDisk"`
+}
+
+// Field is a single name/value pair of a Sonarr provider-style configuration
+// (auto tagging specifications, metadata consumers, etc.).
+type Field struct {
+	Name  string `json:"name"`
+	Value any    `json:"value,omitempty"`
+}
+
+// AutoTagging represents an auto tagging rule.
+type AutoTagging struct {
+	Id                      int32                      `json:"id,omitempty"`
+	Name                    string                     `json:"name"`
+	RemoveTagsAutomatically bool                       `json:"removeTagsAutomatically"`
+	Tags                    []int32                    `json:"tags"`
+	Specifications          []AutoTaggingSpecification `json:"specifications"`
+}
+
+// AutoTaggingSpecification is a single condition of an auto tagging rule.
+type AutoTaggingSpecification struct {
+	Id                 int32   `json:"id,omitempty"`
+	Name               string  `json:"name"`
+	Implementation     string  `json:"implementation"`
+	ImplementationName string  `json:"implementationName,omitempty"`
+	Negate             bool    `json:"negate"`
+	Required           bool    `json:"required"`
+	Fields             []Field `json:"fields"`
+}
+
+// Metadata represents a metadata consumer (Kodi, Emby, Roksbox, WDTV, Plex).
+type Metadata struct {
+	Id             int32   `json:"id,omitempty"`
+	Name           string  `json:"name"`
+	Implementation string  `json:"implementation"`
+	ConfigContract string  `json:"configContract"`
+	Enable         bool    `json:"enable"`
+	Tags           []int32 `json:"tags"`
+	Fields         []Field `json:"fields"`
+}
+
+// Episode represents a single episode of a series.
+type Episode struct {
+	Id                    int32  `json:"id"`
+	SeriesId              int32  `json:"seriesId"`
+	TvdbId                int32  `json:"tvdbId"`
+	EpisodeFileId         int32  `json:"episodeFileId"`
+	SeasonNumber          int32  `json:"seasonNumber"`
+	EpisodeNumber         int32  `json:"episodeNumber"`
+	AbsoluteEpisodeNumber int32  `json:"absoluteEpisodeNumber"`
+	Title                 string `json:"title"`
+	AirDate               string `json:"airDate"`
+	AirDateUtc            string `json:"airDateUtc"`
+	Overview              string `json:"overview"`
+	HasFile               bool   `json:"hasFile"`
+	Monitored             bool   `json:"monitored"`
+}
+
+// EpisodesMonitor is the request body of the episode monitor endpoint.
+type EpisodesMonitor struct {
+	EpisodeIds []int32 `json:"episodeIds"`
+	Monitored  bool    `json:"monitored"`
+}
+
+// QualityProfile is a Sonarr quality profile. Only the identifying fields are mapped.
+type QualityProfile struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// RootFolder is a root folder registered in Sonarr.
+type RootFolder struct {
+	Id         int32  `json:"id"`
+	Path       string `json:"path"`
+	Accessible bool   `json:"accessible"`
+	FreeSpace  int64  `json:"freeSpace"`
+}
+
+// Command is a task queued or run by Sonarr.
+type Command struct {
+	Id                int32          `json:"id"`
+	Name              string         `json:"name"`
+	CommandName       string         `json:"commandName"`
+	Message           string         `json:"message,omitempty"`
+	Body              map[string]any `json:"body,omitempty"`
+	Priority          string         `json:"priority,omitempty"`
+	Status            string         `json:"status"`
+	Result            string         `json:"result,omitempty"`
+	Queued            string         `json:"queued,omitempty"`
+	Started           string         `json:"started,omitempty"`
+	Ended             string         `json:"ended,omitempty"`
+	Duration          string         `json:"duration,omitempty"`
+	Exception         string         `json:"exception,omitempty"`
+	Trigger           string         `json:"trigger,omitempty"`
+	StateChangeTime   string         `json:"stateChangeTime,omitempty"`
+	LastExecutionTime string         `json:"lastExecutionTime,omitempty"`
+}
+
+// QueueItem is a download tracked by Sonarr's queue.
+type QueueItem struct {
+	Id                      int32                `json:"id"`
+	SeriesId                int32                `json:"seriesId"`
+	EpisodeId               int32                `json:"episodeId"`
+	SeasonNumber            int32                `json:"seasonNumber"`
+	Title                   string               `json:"title"`
+	Status                  string               `json:"status"`
+	TrackedDownloadStatus   string               `json:"trackedDownloadStatus"`
+	TrackedDownloadState    string               `json:"trackedDownloadState"`
+	StatusMessages          []QueueStatusMessage `json:"statusMessages"`
+	ErrorMessage            string               `json:"errorMessage"`
+	DownloadId              string               `json:"downloadId"`
+	Protocol                string               `json:"protocol"`
+	DownloadClient          string               `json:"downloadClient"`
+	Indexer                 string               `json:"indexer"`
+	OutputPath              string               `json:"outputPath"`
+	Size                    float64              `json:"size"`
+	SizeLeft                float64              `json:"sizeleft"`
+	TimeLeft                string               `json:"timeleft"`
+	EstimatedCompletionTime string               `json:"estimatedCompletionTime"`
+	Added                   string               `json:"added"`
+}
+
+// QueueStatusMessage is a warning or error Sonarr reports for a queued download.
+type QueueStatusMessage struct {
+	Title    string   `json:"title"`
+	Messages []string `json:"messages"`
+}
+
+// HistoryRecord is an event of Sonarr's history, e.g. a grab or an import.
+type HistoryRecord struct {
+	Id                  int32             `json:"id"`
+	EpisodeId           int32             `json:"episodeId"`
+	SeriesId            int32             `json:"seriesId"`
+	SourceTitle         string            `json:"sourceTitle"`
+	QualityCutoffNotMet bool              `json:"qualityCutoffNotMet"`
+	Date                string            `json:"date"`
+	DownloadId          string            `json:"downloadId"`
+	EventType           string            `json:"eventType"`
+	Data                map[string]string `json:"data"`
 }
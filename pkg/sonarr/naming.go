@@ -0,0 +1,188 @@
+package sonarr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultSeriesFolderFormat is Sonarr's default series folder format.
+const DefaultSeriesFolderFormat = "{Series TitleYear}"
+
+// namingTokenRegex matches a naming token such as {Series TitleYear}, {series.title} or {[TvdbId]}.
+// It mirrors the token regex of Sonarr's FileNameBuilder without the custom format part.
+var namingTokenRegex = regexp.MustCompile(`(?i)\{(?P<prefix>[- ._\[(]*)(?P<token>[a-z0-9]+(?:(?P<separator>[- ._]+)[a-z0-9]+)?)(?::[ ,a-z0-9+-]+)?(?P<suffix>[- ._)\]]*)\}`)
+
+// titleArticleRegex matches a leading English article moved to the end by the TitleThe tokens.
+var titleArticleRegex = regexp.MustCompile(`(?i)^(The|An|A) (.*?)((?: *\([^)]+\))*)$`)
+
+// titleYearRegex matches a year already present at the end of a title.
+var titleYearRegex = regexp.MustCompile(`\(\d{4}\)$`)
+
+// CleanTitle applies Sonarr's "clean title" rules used by the {Series CleanTitle} token:
+// "&" becomes "and", slashes become spaces, brackets and stray punctuation are removed
+// and diacritics are stripped.
+func CleanTitle(title string) string {
+	title = strings.ReplaceAll(title, "&", "and")
+	title = strings.ReplaceAll(title, "/", " ")
+
+	chars := []rune(title)
+	var b strings.Builder
+	for i, r := range chars {
+		switch {
+		case strings.ContainsRune("()[]{}", r):
+			continue
+		case strings.ContainsRune(",<>\\;:'\"|`’~!?@%*-_=", r) &&
+			i > 0 && unicode.IsSpace(chars[i-1]) && i+1 < len(chars) && unicode.IsSpace(chars[i+1]):
+			// Punctuation standing alone between two spaces
+			continue
+		case strings.ContainsRune("'`’:?,", r) && endsContraction(chars[i+1:]):
+			// Apostrophes of contractions and trailing punctuation
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return removeDiacritics(b.String())
+}
+
+// endsContraction reports whether rest starts with a contraction suffix followed by whitespace,
+// starts with whitespace or is empty.
+func endsContraction(rest []rune) bool {
+	if len(rest) == 0 || unicode.IsSpace(rest[0]) {
+		return true
+	}
+	lower := strings.ToLower(string(rest))
+	for _, suffix := range []string{"s", "m", "t", "ve", "ll", "d", "re"} {
+		if after, ok := strings.CutPrefix(lower, suffix); ok && after != "" && unicode.IsSpace([]rune(after)[0]) {
+			return true
+		}
+	}
+	return false
+}
+
+func removeDiacritics(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	result, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return result
+}
+
+// TitleThe moves a leading article to the end of the title ("The Office" becomes "Office, The").
+func TitleThe(title string) string {
+	m := titleArticleRegex.FindStringSubmatch(title)
+	if m == nil {
+		return title
+	}
+	return strings.TrimSpace(m[2]+", "+m[1]) + m[3]
+}
+
+// titleWithYear appends the year to the title unless it is unknown or already present.
+func titleWithYear(title string, year int) string {
+	if year == 0 || titleYearRegex.MatchString(title) {
+		return title
+	}
+	return fmt.Sprintf("%s (%d)", title, year)
+}
+
+// FormatSeriesFolder renders a Sonarr series folder format offline.
+// Supported tokens are the {Series ...} title variants, {Series Year} and {TvdbId}, with Sonarr's
+// separator ({Series.Title}) and casing ({series title}, {SERIES TITLE}) rules. Unknown tokens are
+// left untouched. The result is cleaned of characters that are invalid in folder names.
+func FormatSeriesFolder(format, title string, year, tvdbId int) string {
+	if format == "" {
+		format = DefaultSeriesFolderFormat
+	}
+
+	tokens := map[string]string{
+		"series title":                    title,
+		"series titleyear":                titleWithYear(title, year),
+		"series titlewithoutyear":         strings.TrimSpace(titleYearRegex.ReplaceAllString(title, "")),
+		"series cleantitle":               CleanTitle(title),
+		"series cleantitleyear":           CleanTitle(titleWithYear(title, year)),
+		"series cleantitlewithoutyear":    CleanTitle(strings.TrimSpace(titleYearRegex.ReplaceAllString(title, ""))),
+		"series titlethe":                 TitleThe(title),
+		"series titletheyear":             titleWithYear(TitleThe(title), year),
+		"series titlethewithoutyear":      TitleThe(strings.TrimSpace(titleYearRegex.ReplaceAllString(title, ""))),
+		"series cleantitlethe":            CleanTitle(TitleThe(title)),
+		"series cleantitletheyear":        CleanTitle(titleWithYear(TitleThe(title), year)),
+		"series cleantitlethewithoutyear": CleanTitle(TitleThe(strings.TrimSpace(titleYearRegex.ReplaceAllString(title, "")))),
+		"series titlefirstcharacter":      titleFirstCharacter(title),
+		"series year":                     "",
+		"tvdbid":                          "",
+	}
+	if year != 0 {
+		tokens["series year"] = strconv.Itoa(year)
+	}
+	if tvdbId != 0 {
+		tokens["tvdbid"] = strconv.Itoa(tvdbId)
+	}
+
+	folder := namingTokenRegex.ReplaceAllStringFunc(format, func(match string) string {
+		m := namingTokenRegex.FindStringSubmatch(match)
+		prefix, token, separator, suffix := m[1], m[2], m[3], m[4]
+
+		key := strings.ToLower(token)
+		if separator != "" {
+			key = strings.Replace(key, strings.ToLower(separator), " ", 1)
+		}
+		value, ok := tokens[key]
+		if !ok {
+			return match
+		}
+		if value == "" {
+			return ""
+		}
+
+		switch {
+		case token == strings.ToLower(token):
+			value = strings.ToLower(value)
+		case token == strings.ToUpper(token):
+			value = strings.ToUpper(value)
+		}
+		if separator != "" && separator != " " {
+			value = strings.ReplaceAll(value, " ", separator)
+		}
+		return prefix + value + suffix
+	})
+
+	return cleanFolderName(folder)
+}
+
+// titleFirstCharacter returns the upper-cased first letter or digit of TitleThe, "_" if there is none.
+func titleFirstCharacter(title string) string {
+	for _, r := range TitleThe(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return strings.ToUpper(string(r))
+		}
+	}
+	return "_"
+}
+
+// folderNameReplacer mirrors Sonarr's bad file name characters with the default smart colon replacement.
+var folderNameReplacer = strings.NewReplacer(
+	": ", " - ",
+	":", "-",
+	"\\", "+",
+	"/", "+",
+	"<", "",
+	">", "",
+	"?", "!",
+	"*", "-",
+	"|", "",
+	"\"", "",
+)
+
+// cleanFolderName replaces characters that are invalid in folder names and trims trailing dots and spaces.
+func cleanFolderName(name string) string {
+	name = folderNameReplacer.Replace(name)
+	return strings.Trim(name, " .")
+}
@@ -0,0 +1,79 @@
+package sonarr
+
+import "testing"
+
+func TestCleanTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"The Office (US)", "The Office US"},
+		{"Law & Order: Special Victims Unit", "Law and Order Special Victims Unit"},
+		{"Marvel's Agents of S.H.I.E.L.D.", "Marvels Agents of S.H.I.E.L.D."},
+		{"Face/Off", "Face Off"},
+		{"Pokémon", "Pokemon"},
+		{"Who Wants to Be a Millionaire?", "Who Wants to Be a Millionaire"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := CleanTitle(tt.title); got != tt.want {
+				t.Errorf("CleanTitle(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTitleThe(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"The Office", "Office, The"},
+		{"A Series of Unfortunate Events", "Series of Unfortunate Events, A"},
+		{"The Office (US)", "Office, The (US)"},
+		{"Theodosia", "Theodosia"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := TitleThe(tt.title); got != tt.want {
+				t.Errorf("TitleThe(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSeriesFolder(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		title  string
+		year   int
+		tvdbId int
+		want   string
+	}{
+		{"default format", "", "The Office", 2005, 73244, "The Office (2005)"},
+		{"year already in title", "{Series TitleYear}", "Doctor Who (2005)", 2005, 78804, "Doctor Who (2005)"},
+		{"unknown year", "{Series TitleYear}", "The Office", 0, 0, "The Office"},
+		{"without year", "{Series TitleWithoutYear}", "Doctor Who (2005)", 2005, 0, "Doctor Who"},
+		{"title the", "{Series TitleThe}", "The Office", 2005, 0, "Office, The"},
+		{"clean title", "{Series CleanTitle}", "Law & Order: SVU", 1999, 0, "Law and Order SVU"},
+		{"separator", "{Series.Title}", "The Office", 2005, 0, "The.Office"},
+		{"lower case", "{series title}", "The Office", 2005, 0, "the office"},
+		{"upper case", "{SERIES TITLE}", "The Office", 2005, 0, "THE OFFICE"},
+		{"tvdb id", "{Series Title} {[TvdbId]}", "The Office", 2005, 73244, "The Office [73244]"},
+		{"missing tvdb id", "{Series Title} {[TvdbId]}", "The Office", 2005, 0, "The Office"},
+		{"first character", "{Series TitleFirstCharacter}/{Series Title}", "The Office", 2005, 0, "O+The Office"},
+		{"unknown token", "{Series Title} {Quality Full}", "The Office", 2005, 0, "The Office {Quality Full}"},
+		{"invalid characters", "{Series Title}", "Marvel's Agents: S.H.I.E.L.D.?", 2013, 0, "Marvel's Agents - S.H.I.E.L.D.!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatSeriesFolder(tt.format, tt.title, tt.year, tt.tvdbId); got != tt.want {
+				t.Errorf("FormatSeriesFolder(%q, %q, %d, %d) = %q, want %q", tt.format, tt.title, tt.year, tt.tvdbId, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+)
+
+// defaultPageSize is the number of records requested per page when PageRequest.PageSize is not set.
+const defaultPageSize = 250
+
+// Sort directions of the paged endpoints.
+const (
+	SortAscending  = "ascending"
+	SortDescending = "descending"
+)
+
+// Page is the envelope shared by Sonarr's paged endpoints, e.g. queue, history, wanted, log and blocklist.
+type Page[T any] struct {
+	Page          int    `json:"page"`
+	PageSize      int    `json:"pageSize"`
+	SortKey       string `json:"sortKey"`
+	SortDirection string `json:"sortDirection"`
+	TotalRecords  int    `json:"totalRecords"`
+	Records       []T    `json:"records"`
+}
+
+// PageRequest selects the records Paginate streams from a paged endpoint.
+type PageRequest struct {
+	// PageSize is the number of records requested per page, defaultPageSize if zero.
+	PageSize int
+	// SortKey and SortDirection order the records, the endpoint default if empty.
+	SortKey       string
+	SortDirection string
+	// Query holds the endpoint specific filters, e.g. seriesIds or eventType.
+	Query url2.Values
+	// Limit stops the iteration after this many records when it is greater than zero.
+	Limit int
+}
+
+// Paginate streams the records of the paged endpoint at path, e.g. "queue" or "wanted", "missing",
+// below /api/v3. Pages are only requested as the iteration reaches them, so stopping early saves
+// the remaining requests. The first error, including the cancellation of ctx, is yielded with a
+// zero record and ends the iteration.
+func Paginate[T any](ctx context.Context, c *Client, request PageRequest, path ...string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		size := request.PageSize
+		if size <= 0 {
+			size = defaultPageSize
+		}
+
+		count := 0
+		for number := 1; ; number++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := getPage[T](ctx, c, request, size, number, path)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, record := range page.Records {
+				if !yield(record, nil) {
+					return
+				}
+				count++
+				if request.Limit > 0 && count >= request.Limit {
+					return
+				}
+			}
+
+			// Counting records rather than pages copes with a server capping the page size.
+			if len(page.Records) == 0 || count >= page.TotalRecords {
+				return
+			}
+		}
+	}
+}
+
+// getPage requests a single page of a paged endpoint.
+func getPage[T any](ctx context.Context, c *Client, request PageRequest, size, number int, path []string) (*Page[T], error) {
+	result := Page[T]{}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath(append([]string{"api", "v3"}, path...)...)
+	q := url2.Values{}
+	for key, values := range request.Query {
+		q[key] = values
+	}
+	q.Set("page", strconv.Itoa(number))
+	q.Set("pageSize", strconv.Itoa(size))
+	if request.SortKey != "" {
+		q.Set("sortKey", request.SortKey)
+	}
+	if request.SortDirection != "" {
+		q.Set("sortDirection", request.SortDirection)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
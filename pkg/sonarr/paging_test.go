@@ -0,0 +1,281 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	url2 "net/url"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+type testRecord struct {
+	Id int `json:"id"`
+}
+
+// pagedServer stands in for a Sonarr paged endpoint at /api/v3/test serving total records.
+// maxPageSize caps the page size like a server ignoring large requests would, zero for no cap.
+// It counts the requests and keeps the query of the last one.
+type pagedServer struct {
+	*httptest.Server
+	total       int
+	maxPageSize int
+	requests    atomic.Int32
+	lastQuery   atomic.Pointer[url2.Values]
+}
+
+func newPagedServer(t *testing.T, total, maxPageSize int) *pagedServer {
+	t.Helper()
+
+	s := &pagedServer{total: total, maxPageSize: maxPageSize}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		query := r.URL.Query()
+		s.lastQuery.Store(&query)
+
+		if r.URL.Path != "/api/v3/test" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("X-Api-Key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		number, _ := strconv.Atoi(query.Get("page"))
+		size, _ := strconv.Atoi(query.Get("pageSize"))
+		if s.maxPageSize > 0 && size > s.maxPageSize {
+			size = s.maxPageSize
+		}
+
+		page := Page[testRecord]{
+			Page:          number,
+			PageSize:      size,
+			SortKey:       query.Get("sortKey"),
+			SortDirection: query.Get("sortDirection"),
+			TotalRecords:  s.total,
+			Records:       []testRecord{},
+		}
+		for id := (number-1)*size + 1; id <= min(number*size, s.total); id++ {
+			page.Records = append(page.Records, testRecord{Id: id})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// collect drains the iterator, returning the record IDs and the first error.
+func collect(records iter.Seq2[testRecord, error]) ([]int, error) {
+	var ids []int
+	for record, err := range records {
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, record.Id)
+	}
+	return ids, nil
+}
+
+func sequence(from, to int) []int {
+	ids := []int{}
+	for id := from; id <= to; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestPaginateStreamsAllPages(t *testing.T) {
+	server := newPagedServer(t, 25, 0)
+	client := NewClient(server.URL, "key")
+
+	ids, err := collect(Paginate[testRecord](context.Background(), client, PageRequest{PageSize: 10}, "test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !slices.Equal(ids, sequence(1, 25)) {
+		t.Errorf("records = %v, want 1 to 25", ids)
+	}
+	if got := server.requests.Load(); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+}
+
+func TestPaginateSendsPagingAndQuery(t *testing.T) {
+	server := newPagedServer(t, 5, 0)
+	client := NewClient(server.URL, "key")
+
+	request := PageRequest{
+		SortKey:       "date",
+		SortDirection: SortDescending,
+		Query:         url2.Values{"seriesIds": {"1", "2"}},
+	}
+	if _, err := collect(Paginate[testRecord](context.Background(), client, request, "test")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	query := *server.lastQuery.Load()
+	checks := map[string]string{
+		"page":          "1",
+		"pageSize":      strconv.Itoa(defaultPageSize),
+		"sortKey":       "date",
+		"sortDirection": SortDescending,
+	}
+	for key, want := range checks {
+		if got := query.Get(key); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+	if got := query["seriesIds"]; !slices.Equal(got, []string{"1", "2"}) {
+		t.Errorf("seriesIds = %v, want [1 2]", got)
+	}
+	if len(request.Query) != 1 {
+		t.Errorf("the query of the request was modified: %v", request.Query)
+	}
+}
+
+func TestPaginateEmpty(t *testing.T) {
+	server := newPagedServer(t, 0, 0)
+	client := NewClient(server.URL, "key")
+
+	ids, err := collect(Paginate[testRecord](context.Background(), client, PageRequest{}, "test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("records = %v, want none", ids)
+	}
+	if got := server.requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestPaginateCappedPageSize(t *testing.T) {
+	server := newPagedServer(t, 12, 5)
+	client := NewClient(server.URL, "key")
+
+	ids, err := collect(Paginate[testRecord](context.Background(), client, PageRequest{PageSize: 100}, "test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !slices.Equal(ids, sequence(1, 12)) {
+		t.Errorf("records = %v, want 1 to 12", ids)
+	}
+	if got := server.requests.Load(); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+}
+
+func TestPaginateIsLazy(t *testing.T) {
+	server := newPagedServer(t, 100, 0)
+	client := NewClient(server.URL, "key")
+
+	var ids []int
+	for record, err := range Paginate[testRecord](context.Background(), client, PageRequest{PageSize: 10}, "test") {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		ids = append(ids, record.Id)
+		if len(ids) == 15 {
+			break
+		}
+	}
+
+	if !slices.Equal(ids, sequence(1, 15)) {
+		t.Errorf("records = %v, want 1 to 15", ids)
+	}
+	if got := server.requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestPaginateLimit(t *testing.T) {
+	server := newPagedServer(t, 100, 0)
+	client := NewClient(server.URL, "key")
+
+	ids, err := collect(Paginate[testRecord](context.Background(), client, PageRequest{PageSize: 10, Limit: 20}, "test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !slices.Equal(ids, sequence(1, 20)) {
+		t.Errorf("records = %v, want 1 to 20", ids)
+	}
+	if got := server.requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2, the limit was reached at the end of the second page", got)
+	}
+}
+
+func TestPaginateContextCancelled(t *testing.T) {
+	server := newPagedServer(t, 100, 0)
+	client := NewClient(server.URL, "key")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var ids []int
+	var iterErr error
+	for record, err := range Paginate[testRecord](ctx, client, PageRequest{PageSize: 10}, "test") {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		ids = append(ids, record.Id)
+		if len(ids) == 10 {
+			cancel()
+		}
+	}
+
+	if !errors.Is(iterErr, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", iterErr)
+	}
+	if !slices.Equal(ids, sequence(1, 10)) {
+		t.Errorf("records = %v, want 1 to 10", ids)
+	}
+	if got := server.requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestPaginateAlreadyCancelled(t *testing.T) {
+	server := newPagedServer(t, 10, 0)
+	client := NewClient(server.URL, "key")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := collect(Paginate[testRecord](ctx, client, PageRequest{}, "test"))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+	if got := server.requests.Load(); got != 0 {
+		t.Errorf("requests = %d, want 0", got)
+	}
+}
+
+func TestPaginateErrors(t *testing.T) {
+	server := newPagedServer(t, 10, 0)
+
+	t.Run("status code", func(t *testing.T) {
+		client := NewClient(server.URL, "key")
+		ids, err := collect(Paginate[testRecord](context.Background(), client, PageRequest{}, "missing"))
+		if err == nil || err.Error() != "API error: status code 404" {
+			t.Errorf("error = %v, want API error: status code 404", err)
+		}
+		if len(ids) != 0 {
+			t.Errorf("records = %v, want none", ids)
+		}
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		client := NewClient(server.URL, "wrong")
+		_, err := collect(Paginate[testRecord](context.Background(), client, PageRequest{}, "test"))
+		if !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("error = %v, want ErrUnauthorized", err)
+		}
+	})
+}
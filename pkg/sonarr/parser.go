@@ -0,0 +1,176 @@
+package sonarr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParsedRelease is the information extracted from a release name by ParseRelease.
+type ParsedRelease struct {
+	SeriesTitle    string
+	Year           int
+	SeasonNumber   int
+	EpisodeNumbers []int
+	FullSeason     bool
+	AirDate        string
+	Quality        string
+	Source         string
+	Resolution     string
+	ReleaseGroup   string
+	Proper         bool
+	Repack         bool
+}
+
+// The release parser is a small offline subset of Sonarr's Parser/QualityParser. It understands
+// the common scene and P2P naming schemes; anime absolute numbering is not supported.
+var (
+	releaseExtensionRegex  = regexp.MustCompile(`(?i)\.(?:mkv|mp4|avi|m4v|ts|wmv|nzb|torrent)$`)
+	releaseSiteSuffixRegex = regexp.MustCompile(`(?i)\s*\[[^\]]+\]$`)
+	titleYearSuffixRegex   = regexp.MustCompile(`^(.+?)\s+\(?((?:19|20)\d{2})\)?$`)
+
+	standardEpisodeRegex = regexp.MustCompile(`(?i)(?:^|[-_. (\[])S(\d{1,4})((?:[-_. ]?E\d{1,4})+)(?:[-_. )\]]|$)`)
+	episodeNumberRegex   = regexp.MustCompile(`(?i)E(\d{1,4})`)
+	crossEpisodeRegex    = regexp.MustCompile(`(?i)(?:^|[-_. (\[])(\d{1,2})x(\d{2,3})(?:-?x?(\d{2,3}))?(?:[-_. )\]]|$)`)
+	fullSeasonRegex      = regexp.MustCompile(`(?i)(?:^|[-_. (\[])(?:S|Season[-_. ]?)(\d{1,4})(?:[-_. )\]]|$)`)
+	dailyEpisodeRegex    = regexp.MustCompile(`(?:^|[-_. (\[])((?:19|20)\d{2})[-_. ](\d{2})[-_. ](\d{2})(?:[-_. )\]]|$)`)
+
+	resolutionRegex = regexp.MustCompile(`(?i)\b(2160p|4k|uhd|1080[pi]|720p|576p|480p)\b`)
+	remuxRegex      = regexp.MustCompile(`(?i)\b(?:bd)?remux\b`)
+	blurayRegex     = regexp.MustCompile(`(?i)\b(?:blu-?ray|bd(?:rip)?|br-?rip|bdiso|bd25|bd50)\b`)
+	webripRegex     = regexp.MustCompile(`(?i)\bweb-?rip\b`)
+	webdlRegex      = regexp.MustCompile(`(?i)\b(?:web-?dl|webhd|web|amzn|nf|dsnp|hmax|atvp|hulu|pcok|itunes)\b`)
+	hdtvRegex       = regexp.MustCompile(`(?i)\bhdtv\b`)
+	dvdRegex        = regexp.MustCompile(`(?i)\b(?:dvd(?:rip)?|ntsc|pal|xvidvd)\b`)
+	sdtvRegex       = regexp.MustCompile(`(?i)\b(?:sdtv|pdtv|dsr|tvrip)\b`)
+	properRegex     = regexp.MustCompile(`(?i)\bproper\b`)
+	repackRegex     = regexp.MustCompile(`(?i)\b(?:repack|rerip)\b`)
+
+	releaseGroupRegex = regexp.MustCompile(`-([a-zA-Z0-9]+)$`)
+)
+
+// ParseRelease extracts the series title and year, season/episode numbers, quality and release group
+// from a release name. It returns an error if no season, episode or air date can be found.
+func ParseRelease(name string) (*ParsedRelease, error) {
+	full := releaseExtensionRegex.ReplaceAllString(strings.TrimSpace(name), "")
+	// A trailing bracket holds a site tag on releases but the quality in Sonarr's own file
+	// names, so the quality is parsed before it is stripped.
+	release := releaseSiteSuffixRegex.ReplaceAllString(full, "")
+
+	parsed := &ParsedRelease{EpisodeNumbers: []int{}}
+
+	var titleEnd int
+	if m := standardEpisodeRegex.FindStringSubmatchIndex(release); m != nil {
+		titleEnd = m[0]
+		parsed.SeasonNumber, _ = strconv.Atoi(release[m[2]:m[3]])
+		for _, e := range episodeNumberRegex.FindAllStringSubmatch(release[m[4]:m[5]], -1) {
+			episode, _ := strconv.Atoi(e[1])
+			parsed.EpisodeNumbers = append(parsed.EpisodeNumbers, episode)
+		}
+	} else if m := crossEpisodeRegex.FindStringSubmatchIndex(release); m != nil {
+		titleEnd = m[0]
+		parsed.SeasonNumber, _ = strconv.Atoi(release[m[2]:m[3]])
+		first, _ := strconv.Atoi(release[m[4]:m[5]])
+		last := first
+		if m[6] >= 0 {
+			last, _ = strconv.Atoi(release[m[6]:m[7]])
+		}
+		for episode := first; episode <= last; episode++ {
+			parsed.EpisodeNumbers = append(parsed.EpisodeNumbers, episode)
+		}
+	} else if m := dailyEpisodeRegex.FindStringSubmatchIndex(release); m != nil {
+		titleEnd = m[0]
+		parsed.AirDate = fmt.Sprintf("%s-%s-%s", release[m[2]:m[3]], release[m[4]:m[5]], release[m[6]:m[7]])
+	} else if m := fullSeasonRegex.FindStringSubmatchIndex(release); m != nil {
+		titleEnd = m[0]
+		parsed.SeasonNumber, _ = strconv.Atoi(release[m[2]:m[3]])
+		parsed.FullSeason = true
+	} else {
+		return nil, fmt.Errorf("unable to parse season or episode from %q", name)
+	}
+
+	parsed.SeriesTitle = cleanReleaseTitle(release[:titleEnd])
+	if m := titleYearSuffixRegex.FindStringSubmatch(parsed.SeriesTitle); m != nil {
+		parsed.SeriesTitle = m[1]
+		parsed.Year, _ = strconv.Atoi(m[2])
+	}
+	parsed.Resolution, parsed.Source, parsed.Quality = parseQuality(full)
+	parsed.Proper = properRegex.MatchString(full)
+	parsed.Repack = repackRegex.MatchString(full)
+
+	if m := releaseGroupRegex.FindStringSubmatchIndex(release); m != nil {
+		group := release[m[2]:m[3]]
+		// "WEB-DL" and friends end with something that looks like a group
+		if !strings.EqualFold(group, "DL") && !strings.EqualFold(group, "Rip") {
+			parsed.ReleaseGroup = group
+		}
+	}
+
+	return parsed, nil
+}
+
+// cleanReleaseTitle turns the title part of a release name into a readable title.
+func cleanReleaseTitle(title string) string {
+	title = strings.NewReplacer(".", " ", "_", " ").Replace(title)
+	return strings.Trim(strings.Join(strings.Fields(title), " "), " -[(")
+}
+
+// parseQuality returns the resolution, source and Sonarr quality name of a release.
+func parseQuality(release string) (resolution, source, quality string) {
+	if m := resolutionRegex.FindStringSubmatch(release); m != nil {
+		switch r := strings.ToLower(m[1]); r {
+		case "4k", "uhd":
+			resolution = "2160p"
+		case "1080i":
+			resolution = "1080p"
+		default:
+			resolution = r
+		}
+	}
+
+	switch {
+	case remuxRegex.MatchString(release):
+		source = "bluray"
+		if resolution == "" {
+			resolution = "1080p"
+		}
+		return resolution, source, fmt.Sprintf("Bluray-%s Remux", resolution)
+	case blurayRegex.MatchString(release):
+		source = "bluray"
+		if resolution == "" {
+			resolution = "720p"
+		}
+		return resolution, source, "Bluray-" + resolution
+	case webripRegex.MatchString(release):
+		source = "webrip"
+		if resolution == "" || resolution == "576p" {
+			resolution = "480p"
+		}
+		return resolution, source, "WEBRip-" + resolution
+	case webdlRegex.MatchString(release):
+		source = "webdl"
+		if resolution == "" || resolution == "576p" {
+			resolution = "480p"
+		}
+		return resolution, source, "WEBDL-" + resolution
+	case hdtvRegex.MatchString(release):
+		source = "hdtv"
+		if resolution == "" || resolution == "480p" || resolution == "576p" {
+			return resolution, source, "SDTV"
+		}
+		return resolution, source, "HDTV-" + resolution
+	case dvdRegex.MatchString(release):
+		return resolution, "dvd", "DVD"
+	case sdtvRegex.MatchString(release):
+		return resolution, "television", "SDTV"
+	}
+
+	switch resolution {
+	case "2160p", "1080p", "720p":
+		return resolution, "television", "HDTV-" + resolution
+	case "480p", "576p":
+		return resolution, "television", "SDTV"
+	}
+	return resolution, "unknown", "Unknown"
+}
@@ -0,0 +1,86 @@
+package sonarr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRelease(t *testing.T) {
+	tests := []struct {
+		name string
+		want ParsedRelease
+	}{
+		{
+			name: "The.Office.US.S02E03.720p.HDTV.x264-LOL",
+			want: ParsedRelease{SeriesTitle: "The Office US", SeasonNumber: 2, EpisodeNumbers: []int{3}, Quality: "HDTV-720p", Source: "hdtv", Resolution: "720p", ReleaseGroup: "LOL"},
+		},
+		{
+			name: "Show.Name.S01E01E02.1080p.WEB-DL.DD5.1.H.264-GROUP.mkv",
+			want: ParsedRelease{SeriesTitle: "Show Name", SeasonNumber: 1, EpisodeNumbers: []int{1, 2}, Quality: "WEBDL-1080p", Source: "webdl", Resolution: "1080p", ReleaseGroup: "GROUP"},
+		},
+		{
+			name: "Show Name - 1x02-03 - Title",
+			want: ParsedRelease{SeriesTitle: "Show Name", SeasonNumber: 1, EpisodeNumbers: []int{2, 3}, Quality: "Unknown", Source: "unknown"},
+		},
+		{
+			name: "The.Daily.Show.2023.05.17.720p.WEB.h264-EDITH",
+			want: ParsedRelease{SeriesTitle: "The Daily Show", EpisodeNumbers: []int{}, AirDate: "2023-05-17", Quality: "WEBDL-720p", Source: "webdl", Resolution: "720p", ReleaseGroup: "EDITH"},
+		},
+		{
+			name: "Show.Name.S03.1080p.BluRay.x264-GROUP",
+			want: ParsedRelease{SeriesTitle: "Show Name", SeasonNumber: 3, EpisodeNumbers: []int{}, FullSeason: true, Quality: "Bluray-1080p", Source: "bluray", Resolution: "1080p", ReleaseGroup: "GROUP"},
+		},
+		{
+			name: "Show.Name.S02E04.576p.BluRay.x264-GROUP",
+			want: ParsedRelease{SeriesTitle: "Show Name", SeasonNumber: 2, EpisodeNumbers: []int{4}, Quality: "Bluray-576p", Source: "bluray", Resolution: "576p", ReleaseGroup: "GROUP"},
+		},
+		{
+			name: "Show.Name.S02E04.480p.BluRay.x264-GROUP",
+			want: ParsedRelease{SeriesTitle: "Show Name", SeasonNumber: 2, EpisodeNumbers: []int{4}, Quality: "Bluray-480p", Source: "bluray", Resolution: "480p", ReleaseGroup: "GROUP"},
+		},
+		{
+			name: "Show.Name.S02E04.BDRip.x264-GROUP",
+			want: ParsedRelease{SeriesTitle: "Show Name", SeasonNumber: 2, EpisodeNumbers: []int{4}, Quality: "Bluray-720p", Source: "bluray", Resolution: "720p", ReleaseGroup: "GROUP"},
+		},
+		{
+			name: "Show (2019) - S01E02 - Title [WEBDL-1080p].mkv",
+			want: ParsedRelease{SeriesTitle: "Show", Year: 2019, SeasonNumber: 1, EpisodeNumbers: []int{2}, Quality: "WEBDL-1080p", Source: "webdl", Resolution: "1080p"},
+		},
+		{
+			name: "Doctor.Who.2005.S13E01.1080p.HDTV.x264-GROUP [eztv]",
+			want: ParsedRelease{SeriesTitle: "Doctor Who", Year: 2005, SeasonNumber: 13, EpisodeNumbers: []int{1}, Quality: "HDTV-1080p", Source: "hdtv", Resolution: "1080p", ReleaseGroup: "GROUP"},
+		},
+		{
+			name: "1923.S01E01.720p.WEBRip.x264-GROUP",
+			want: ParsedRelease{SeriesTitle: "1923", SeasonNumber: 1, EpisodeNumbers: []int{1}, Quality: "WEBRip-720p", Source: "webrip", Resolution: "720p", ReleaseGroup: "GROUP"},
+		},
+		{
+			name: "Show.Name.S01E05.PROPER.REPACK.2160p.BluRay.REMUX-GROUP",
+			want: ParsedRelease{SeriesTitle: "Show Name", SeasonNumber: 1, EpisodeNumbers: []int{5}, Quality: "Bluray-2160p Remux", Source: "bluray", Resolution: "2160p", ReleaseGroup: "GROUP", Proper: true, Repack: true},
+		},
+		{
+			name: "Show.Name.S01E05.DVDRip.XviD-GROUP",
+			want: ParsedRelease{SeriesTitle: "Show Name", SeasonNumber: 1, EpisodeNumbers: []int{5}, Quality: "DVD", Source: "dvd", ReleaseGroup: "GROUP"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRelease(tt.name)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("ParseRelease() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseReleaseError(t *testing.T) {
+	for _, name := range []string{"", "Show.Name.1080p.WEB-DL-GROUP"} {
+		if got, err := ParseRelease(name); err == nil {
+			t.Errorf("ParseRelease(%q) = %+v, want an error", name, *got)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	url2 "net/url"
+)
+
+// GetQualityProfiles retrieves all quality profiles.
+func (c *Client) GetQualityProfiles(ctx context.Context) ([]QualityProfile, error) {
+	var profiles []QualityProfile
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "qualityprofile")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&profiles)
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
@@ -0,0 +1,48 @@
+package sonarr
+
+import (
+	"context"
+	"iter"
+	url2 "net/url"
+	"slices"
+	"strconv"
+)
+
+// QueueOptions filters the downloads returned by GetQueue.
+type QueueOptions struct {
+	// SeriesIds only returns downloads of these series.
+	SeriesIds []int32
+	// Status only returns downloads with one of these statuses, e.g. downloading or warning.
+	Status []string
+	// IncludeUnknownSeriesItems also returns downloads Sonarr couldn't match to a series.
+	IncludeUnknownSeriesItems bool
+}
+
+// Queue streams the downloads in the queue, the ones finishing first first. See Paginate.
+func (c *Client) Queue(ctx context.Context, options QueueOptions) iter.Seq2[QueueItem, error] {
+	q := url2.Values{}
+	q.Set("includeUnknownSeriesItems", strconv.FormatBool(options.IncludeUnknownSeriesItems))
+	for _, id := range options.SeriesIds {
+		q.Add("seriesIds", strconv.Itoa(int(id)))
+	}
+
+	return Paginate[QueueItem](ctx, c, PageRequest{
+		SortKey:       "timeleft",
+		SortDirection: SortAscending,
+		Query:         q,
+	}, "queue")
+}
+
+// GetQueue retrieves every download in the queue matching the options.
+func (c *Client) GetQueue(ctx context.Context, options QueueOptions) ([]QueueItem, error) {
+	var items []QueueItem
+	for item, err := range c.Queue(ctx, options) {
+		if err != nil {
+			return nil, err
+		}
+		if len(options.Status) == 0 || slices.Contains(options.Status, item.Status) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
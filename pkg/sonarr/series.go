@@ -2,6 +2,7 @@ package sonarr
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,11 +13,20 @@ import (
 
 // GetAllSeries retrieves all series currently in the Sonarr library.
 // Returns a slice of Series or an error if the API call fails.
-func (c *Client) GetAllSeries() ([]Series, error) {
+// With WithSeriesCache the result may come from the cache.
+func (c *Client) GetAllSeries(ctx context.Context) ([]Series, error) {
+	if c.seriesCache != nil {
+		return c.seriesCache.get(ctx, c.fetchAllSeries)
+	}
+	return c.fetchAllSeries(ctx)
+}
+
+// fetchAllSeries retrieves all series from the API, bypassing the cache.
+func (c *Client) fetchAllSeries(ctx context.Context) ([]Series, error) {
 	var series []Series
 
 	url := fmt.Sprintf("%s/api/v3/series", c.BaseURL)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -39,11 +49,19 @@ func (c *Client) GetAllSeries() ([]Series, error) {
 	return series, nil
 }
 
-func (c *Client) GetSeries(id int) (*Series, error) {
+// GetSeries retrieves a series by ID, nil if it doesn't exist.
+// With WithSeriesCache a series of a cached library is returned without calling Sonarr.
+func (c *Client) GetSeries(ctx context.Context, id int) (*Series, error) {
+	if c.seriesCache != nil {
+		if cached, ok := c.seriesCache.lookup(id); ok {
+			return cached, nil
+		}
+	}
+
 	series := Series{}
 
 	url := fmt.Sprintf("%s/api/v3/series/%d", c.BaseURL, id)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +89,9 @@ func (c *Client) GetSeries(id int) (*Series, error) {
 	return &series, nil
 }
 
-func (c *Client) CreateSeries(show *Series) (*Series, error) {
+func (c *Client) CreateSeries(ctx context.Context, show *Series) (*Series, error) {
+	defer c.invalidateSeriesCache()
+
 	jsonBytes, err := json.Marshal(show)
 	if err != nil {
 		return nil, err
@@ -81,7 +101,7 @@ func (c *Client) CreateSeries(show *Series) (*Series, error) {
 
 	reqReader := bytes.NewBuffer(jsonBytes)
 
-	req, err := http.NewRequest("POST", url, reqReader)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, reqReader)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +126,9 @@ func (c *Client) CreateSeries(show *Series) (*Series, error) {
 	return &result, nil
 }
 
-func (c *Client) DeleteSeries(id int, deleteFiles bool) error {
+func (c *Client) DeleteSeries(ctx context.Context, id int, deleteFiles bool) error {
+	defer c.invalidateSeriesCache()
+
 	u, err := url2.Parse(c.BaseURL)
 	if err != nil {
 		return err
@@ -118,7 +140,7 @@ func (c *Client) DeleteSeries(id int, deleteFiles bool) error {
 	q.Set("deleteFiles", strconv.FormatBool(deleteFiles))
 	u.RawQuery = q.Encode()
 
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
 	if err != nil {
 		return err
 	}
@@ -142,7 +164,11 @@ func (c *Client) DeleteSeries(id int, deleteFiles bool) error {
 	}
 }
 
-func (c *Client) UpdateSeries(show *Series) (*Series, error) {
+// UpdateSeries saves the series. With moveFiles Sonarr moves the series folder on disk
+// when the path changed, otherwise only the path in the database is updated.
+func (c *Client) UpdateSeries(ctx context.Context, show *Series, moveFiles bool) (*Series, error) {
+	defer c.invalidateSeriesCache()
+
 	if show == nil {
 		return nil, fmt.Errorf("series can't be found: %v", show)
 	}
@@ -151,11 +177,19 @@ func (c *Client) UpdateSeries(show *Series) (*Series, error) {
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/api/v3/series/%d", c.BaseURL, show.Id)
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "series", strconv.Itoa(int(show.Id)))
+
+	q := u.Query()
+	q.Set("moveFiles", strconv.FormatBool(moveFiles))
+	u.RawQuery = q.Encode()
 
 	reqReader := bytes.NewBuffer(jsonBytes)
 
-	req, err := http.NewRequest("PUT", url, reqReader)
+	req, err := http.NewRequestWithContext(ctx, "PUT", u.String(), reqReader)
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +221,42 @@ func (c *Client) UpdateSeries(show *Series) (*Series, error) {
 	}
 }
 
+// DeleteSeriesBatch deletes several series in a single call to the series editor.
+func (c *Client) DeleteSeriesBatch(ctx context.Context, editor *SeriesEditorDelete) error {
+	defer c.invalidateSeriesCache()
+
+	jsonBytes, err := json.Marshal(editor)
+	if err != nil {
+		return err
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return err
+	}
+	u = u.JoinPath("api", "v3", "series", "editor")
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	default:
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("DELETE failed: %d %s - %s",
+			res.StatusCode, res.Status, string(body))
+	}
+}
+
 func (s Series) String() string {
 	return fmt.Sprint(s.Title)
 }
@@ -194,7 +264,7 @@ func (s Series) String() string {
 // LookupSeries searches for series on TVDB via Sonarr's lookup endpoint.
 // The term parameter is the search query (e.g., series title).
 // Returns a slice of matching SeriesLookup results or an error if the API call fails.
-func (c *Client) LookupSeries(term string) ([]SeriesLookup, error) {
+func (c *Client) LookupSeries(ctx context.Context, term string) ([]SeriesLookup, error) {
 	var results []SeriesLookup
 
 	u, err := url2.Parse(c.BaseURL)
@@ -207,7 +277,7 @@ func (c *Client) LookupSeries(term string) ([]SeriesLookup, error) {
 	q.Set("term", term)
 	u.RawQuery = q.Encode()
 
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -229,3 +299,47 @@ func (c *Client) LookupSeries(term string) ([]SeriesLookup, error) {
 	}
 	return results, nil
 }
+
+// EditSeries applies the same changes to several series in a single call to the series editor.
+// Returns the updated series.
+func (c *Client) EditSeries(ctx context.Context, editor *SeriesEditor) ([]Series, error) {
+	defer c.invalidateSeriesCache()
+
+	jsonBytes, err := json.Marshal(editor)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url2.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u = u.JoinPath("api", "v3", "series", "editor")
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", u.String(), bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res.Body)
+
+	switch res.StatusCode {
+	case http.StatusAccepted, http.StatusOK:
+		break
+	default:
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("API Error: %d - %s", res.StatusCode, string(bodyBytes))
+	}
+
+	var series []Series
+	decoder := json.NewDecoder(res.Body)
+	err = decoder.Decode(&series)
+	if err != nil {
+		return nil, err
+	}
+	return series, nil
+}
@@ -0,0 +1,155 @@
+package sonarr
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// seriesCache keeps the result of GetAllSeries for a short time and coalesces concurrent
+// requests into a single API call. Any change to the library made through the client
+// invalidates it.
+type seriesCache struct {
+	ttl time.Duration
+
+	mu         sync.Mutex
+	series     []Series
+	expires    time.Time
+	generation uint64
+	inflight   *seriesCall
+}
+
+// seriesCall is a GetAllSeries API call shared by every caller waiting for it.
+type seriesCall struct {
+	done   chan struct{}
+	series []Series
+	err    error
+}
+
+// WithSeriesCache caches the library returned by GetAllSeries for ttl.
+// Concurrent calls share a single request to Sonarr. A ttl of zero disables the cache.
+func WithSeriesCache(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		if ttl <= 0 {
+			c.seriesCache = nil
+			return
+		}
+		c.seriesCache = &seriesCache{ttl: ttl}
+	}
+}
+
+// get returns the cached library, waiting for a running fetch or starting one if needed.
+// The shared fetch outlives the context of the caller that started it, so a cancelled caller
+// doesn't fail the others; each caller stops waiting when its own context is done.
+func (sc *seriesCache) get(ctx context.Context, fetch func(context.Context) ([]Series, error)) ([]Series, error) {
+	sc.mu.Lock()
+	if sc.series != nil && time.Now().Before(sc.expires) {
+		series := sc.series
+		sc.mu.Unlock()
+		return cloneSeries(series), nil
+	}
+
+	call := sc.inflight
+	if call == nil {
+		call = &seriesCall{done: make(chan struct{})}
+		sc.inflight = call
+		generation := sc.generation
+		go sc.run(context.WithoutCancel(ctx), call, generation, fetch)
+	}
+	sc.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-call.done:
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	return cloneSeries(call.series), nil
+}
+
+// lookup returns a series of the cached library without calling Sonarr.
+// It reports false if the library is not cached or doesn't contain the series.
+func (sc *seriesCache) lookup(id int) (*Series, bool) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if sc.series == nil || !time.Now().Before(sc.expires) {
+		return nil, false
+	}
+	for _, series := range sc.series {
+		if int(series.Id) == id {
+			series = series.clone()
+			return &series, true
+		}
+	}
+	return nil, false
+}
+
+// run performs the fetch of a shared call. The result is only cached if the library
+// was not changed while the call was running.
+func (sc *seriesCache) run(ctx context.Context, call *seriesCall, generation uint64, fetch func(context.Context) ([]Series, error)) {
+	series, err := fetch(ctx)
+	if err == nil && series == nil {
+		series = []Series{}
+	}
+
+	sc.mu.Lock()
+	if sc.inflight == call {
+		sc.inflight = nil
+	}
+	if err == nil && generation == sc.generation {
+		sc.series = series
+		sc.expires = time.Now().Add(sc.ttl)
+	}
+	sc.mu.Unlock()
+
+	call.series, call.err = series, err
+	close(call.done)
+}
+
+// cloneSeries deep copies a library so callers can't modify the cached one.
+func cloneSeries(series []Series) []Series {
+	result := make([]Series, 0, len(series))
+	for _, s := range series {
+		result = append(result, s.clone())
+	}
+	return result
+}
+
+// clone returns a copy of the series sharing no slices or pointers with it.
+func (s Series) clone() Series {
+	s.Tags = slices.Clone(s.Tags)
+	s.Seasons = slices.Clone(s.Seasons)
+	for i := range s.Seasons {
+		if s.Seasons[i].Statistics != nil {
+			statistics := *s.Seasons[i].Statistics
+			s.Seasons[i].Statistics = &statistics
+		}
+	}
+	if s.AddOptions != nil {
+		addOptions := *s.AddOptions
+		s.AddOptions = &addOptions
+	}
+	return s
+}
+
+// invalidate drops the cached library. Calls already running are not awaited by new callers.
+func (sc *seriesCache) invalidate() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.series = nil
+	sc.generation++
+	sc.inflight = nil
+}
+
+// invalidateSeriesCache drops the cached library. Methods changing the library defer it
+// unconditionally, as even a failed request may have reached Sonarr.
+func (c *Client) invalidateSeriesCache() {
+	if c.seriesCache != nil {
+		c.seriesCache.invalidate()
+	}
+}
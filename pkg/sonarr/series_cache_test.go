@@ -0,0 +1,255 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// seriesServer stands in for Sonarr's series endpoints, serving a library of a single series.
+// It counts the library requests and can hold them until release is called.
+type seriesServer struct {
+	*httptest.Server
+	requests atomic.Int32
+	started  chan struct{}
+
+	mu    sync.Mutex
+	title string
+	hold  chan struct{}
+}
+
+func newSeriesServer(t *testing.T) *seriesServer {
+	t.Helper()
+
+	s := &seriesServer{title: "Original", started: make(chan struct{}, 100)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v3/series":
+			s.requests.Add(1)
+			// The library is read when the request arrives, so a held request returns the
+			// library as it was before any change made while it was held.
+			s.mu.Lock()
+			series := []Series{{Id: 1, Title: s.title, Tags: []int32{1, 2}, Seasons: []Season{{SeasonNumber: 1, Monitored: true}}}}
+			hold := s.hold
+			s.mu.Unlock()
+
+			s.started <- struct{}{}
+			if hold != nil {
+				<-hold
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(series)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v3/series/1":
+			var series Series
+			if err := json.NewDecoder(r.Body).Decode(&series); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			s.mu.Lock()
+			s.title = series.Title
+			s.mu.Unlock()
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(series)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// holdRequests makes the library requests wait until release is called.
+func (s *seriesServer) holdRequests() (release func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hold := make(chan struct{})
+	s.hold = hold
+	return sync.OnceFunc(func() {
+		s.mu.Lock()
+		s.hold = nil
+		s.mu.Unlock()
+		close(hold)
+	})
+}
+
+func TestSeriesCacheCoalescesRequests(t *testing.T) {
+	server := newSeriesServer(t)
+	client := NewClient(server.URL, "key", WithSeriesCache(time.Minute))
+	release := server.holdRequests()
+	defer release()
+
+	const callers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			series, err := client.GetAllSeries(context.Background())
+			if err == nil && (len(series) != 1 || series[0].Title != "Original") {
+				t.Errorf("series = %v, want the original series", series)
+			}
+			errs <- err
+		}()
+	}
+
+	<-server.started
+	// Give the other callers time to join the running request before it completes.
+	time.Sleep(50 * time.Millisecond)
+	release()
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	if got := server.requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestSeriesCacheTTL(t *testing.T) {
+	server := newSeriesServer(t)
+	client := NewClient(server.URL, "key", WithSeriesCache(100*time.Millisecond))
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := client.GetAllSeries(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if got := server.requests.Load(); got != 1 {
+		t.Errorf("requests within the ttl = %d, want 1", got)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+	if _, err := client.GetAllSeries(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := server.requests.Load(); got != 2 {
+		t.Errorf("requests after the ttl = %d, want 2", got)
+	}
+}
+
+func TestSeriesCacheDisabled(t *testing.T) {
+	server := newSeriesServer(t)
+	client := NewClient(server.URL, "key", WithSeriesCache(0))
+
+	for range 3 {
+		if _, err := client.GetAllSeries(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if got := server.requests.Load(); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+}
+
+func TestSeriesCacheInvalidatedByWrite(t *testing.T) {
+	server := newSeriesServer(t)
+	client := NewClient(server.URL, "key", WithSeriesCache(time.Minute))
+	ctx := context.Background()
+
+	if _, err := client.GetAllSeries(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.UpdateSeries(ctx, &Series{Id: 1, Title: "Renamed"}, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	series, err := client.GetAllSeries(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if series[0].Title != "Renamed" {
+		t.Errorf("title = %q, want Renamed", series[0].Title)
+	}
+	if got := server.requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestSeriesCacheWriteDuringFetch(t *testing.T) {
+	server := newSeriesServer(t)
+	client := NewClient(server.URL, "key", WithSeriesCache(time.Minute))
+	ctx := context.Background()
+	release := server.holdRequests()
+	defer release()
+
+	stale := make(chan []Series, 1)
+	go func() {
+		series, err := client.GetAllSeries(ctx)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+		stale <- series
+	}()
+
+	<-server.started
+	if _, err := client.UpdateSeries(ctx, &Series{Id: 1, Title: "Renamed"}, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	release()
+	if series := <-stale; len(series) != 1 || series[0].Title != "Original" {
+		t.Fatalf("series of the held request = %v, want the original series", series)
+	}
+
+	series, err := client.GetAllSeries(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if series[0].Title != "Renamed" {
+		t.Errorf("title = %q, want Renamed, the library fetched before the write was cached", series[0].Title)
+	}
+	cached, err := client.GetSeries(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cached == nil || cached.Title != "Renamed" {
+		t.Errorf("GetSeries() = %v, want the renamed series", cached)
+	}
+	if got := server.requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestSeriesCacheReturnsCopies(t *testing.T) {
+	server := newSeriesServer(t)
+	client := NewClient(server.URL, "key", WithSeriesCache(time.Minute))
+	ctx := context.Background()
+
+	series, err := client.GetAllSeries(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	series[0].Title = "Changed"
+	series[0].Tags[0] = 99
+	series[0].Seasons[0].Monitored = false
+
+	single, err := client.GetSeries(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	single.Tags[1] = 98
+
+	series, err = client.GetAllSeries(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if series[0].Title != "Original" || !slices.Equal(series[0].Tags, []int32{1, 2}) || !series[0].Seasons[0].Monitored {
+		t.Errorf("cached series = %v with tags %v and seasons %v, want the original series with tags [1 2] and a monitored season",
+			series[0], series[0].Tags, series[0].Seasons)
+	}
+	if got := server.requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
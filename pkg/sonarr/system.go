@@ -1,18 +1,19 @@
 package sonarr
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) GetSystemStatus() (*SystemStatus, error) {
+func (c *Client) GetSystemStatus(ctx context.Context) (*SystemStatus, error) {
 	status := SystemStatus{}
 
 	url := c.BaseURL + "/api/v3/system/status"
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +37,67 @@ func (c *Client) GetSystemStatus() (*SystemStatus, error) {
 	return &status, nil
 }
 
+// GetHealth retrieves the issues currently reported by Sonarr's health checks.
+// An empty slice means Sonarr is healthy.
+func (c *Client) GetHealth(ctx context.Context) ([]HealthCheck, error) {
+	var checks []HealthCheck
+
+	url := c.BaseURL + "/api/v3/health"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&checks)
+	if err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// GetDiskSpace retrieves the free and total space of the disks Sonarr uses.
+func (c *Client) GetDiskSpace(ctx context.Context) ([]DiskSpace, error) {
+	var disks []DiskSpace
+
+	url := c.BaseURL + "/api/v3/diskspace"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&disks)
+	if err != nil {
+		return nil, err
+	}
+
+	return disks, nil
+}
+
 func (s SystemStatus) String() string {
 	return fmt.Sprintf("Platform: %s, version: %v", s.AppName, s.Version)
 }
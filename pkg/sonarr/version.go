@@ -0,0 +1,43 @@
+package sonarr
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// MinimumVersion is the oldest Sonarr version the client supports.
+const MinimumVersion = "4.0.0"
+
+// DetectVersion fetches the system status and stores the reported version on the client.
+func (c *Client) DetectVersion(ctx context.Context) (*SystemStatus, error) {
+	status, err := c.GetSystemStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.Version = status.Version
+	return status, nil
+}
+
+// CompareVersions compares two dotted version strings (e.g. "4.0.10.2544") numerically.
+// It returns -1 if a < b, 0 if a == b and 1 if a > b. Missing or non-numeric parts count as 0.
+func CompareVersions(a, b string) int {
+	pa := strings.Split(a, ".")
+	pb := strings.Split(b, ".")
+	for i := 0; i < max(len(pa), len(pb)); i++ {
+		var na, nb int
+		if i < len(pa) {
+			na, _ = strconv.Atoi(pa[i])
+		}
+		if i < len(pb) {
+			nb, _ = strconv.Atoi(pb[i])
+		}
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,54 @@
+package sonarr
+
+import (
+	"context"
+	"iter"
+	url2 "net/url"
+	"slices"
+	"strconv"
+)
+
+// Lists of wanted episodes.
+const (
+	// WantedMissing lists aired episodes without a file.
+	WantedMissing = "missing"
+	// WantedCutoff lists episodes whose file doesn't meet the quality cutoff.
+	WantedCutoff = "cutoff"
+)
+
+// WantedOptions filters the episodes returned by GetWanted.
+type WantedOptions struct {
+	// SeriesIds only returns episodes of these series.
+	SeriesIds []int32
+	// Monitored returns monitored episodes when true and unmonitored ones when false.
+	Monitored bool
+}
+
+// Wanted streams the episodes of the wanted list, WantedMissing or WantedCutoff, most recently
+// aired first. See Paginate.
+func (c *Client) Wanted(ctx context.Context, list string, monitored bool) iter.Seq2[Episode, error] {
+	q := url2.Values{}
+	q.Set("monitored", strconv.FormatBool(monitored))
+
+	return Paginate[Episode](ctx, c, PageRequest{
+		SortKey:       "episodes.airDateUtc",
+		SortDirection: SortDescending,
+		Query:         q,
+	}, "wanted", list)
+}
+
+// GetWanted retrieves every episode of the wanted list, WantedMissing or WantedCutoff,
+// matching the options.
+func (c *Client) GetWanted(ctx context.Context, list string, options WantedOptions) ([]Episode, error) {
+	var episodes []Episode
+	for episode, err := range c.Wanted(ctx, list, options.Monitored) {
+		if err != nil {
+			return nil, err
+		}
+		// The wanted endpoints can't filter by series
+		if len(options.SeriesIds) == 0 || slices.Contains(options.SeriesIds, episode.SeriesId) {
+			episodes = append(episodes, episode)
+		}
+	}
+	return episodes, nil
+}